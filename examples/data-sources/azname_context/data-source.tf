@@ -0,0 +1,27 @@
+# Capture the workload inputs once
+data "azname_context" "workload" {
+  name        = "myapp"
+  environment = "prod"
+  location    = "eastus"
+  prefixes    = ["contoso"]
+}
+
+# Names take the workload inputs from the context
+resource "azname_name" "resource_group" {
+  resource_type = "azurerm_resource_group"
+  context       = data.azname_context.workload.context
+}
+
+# Attributes set on the name override the context
+resource "azname_name" "dr_storage" {
+  resource_type = "azurerm_storage_account"
+  location      = "westus"
+  context       = data.azname_context.workload.context
+}
+
+# Pass the context to modules instead of the individual inputs
+module "networking" {
+  source = "./modules/networking"
+
+  naming_context = data.azname_context.workload.context
+}
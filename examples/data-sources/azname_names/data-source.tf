@@ -0,0 +1,33 @@
+# Generate the names of a workload at once, sharing name, environment and location
+data "azname_names" "landing_zone" {
+  name        = "myapp"
+  environment = "prod"
+  location    = "eastus"
+  random_seed = 12345
+
+  names = {
+    resource_group = {
+      resource_type = "azurerm_resource_group"
+    }
+    vnet = {
+      resource_type = "azurerm_virtual_network"
+    }
+    web_subnet = {
+      resource_type = "azurerm_subnet"
+      service       = "web"
+    }
+    storage = {
+      resource_type = "azurerm_storage_account"
+    }
+    dr_storage = {
+      resource_type = "azurerm_storage_account"
+      location      = "westus"
+    }
+  }
+}
+
+# Results are keyed like names
+resource "azurerm_resource_group" "main" {
+  name     = data.azname_names.landing_zone.results["resource_group"]
+  location = "eastus"
+}
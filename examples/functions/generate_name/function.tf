@@ -0,0 +1,48 @@
+terraform {
+  required_providers {
+    azname = {
+      source = "BHoggs/azname"
+    }
+  }
+}
+
+# Generate a name inline, without a data source
+# Uses the default template unless AZNAME_TEMPLATE is set
+output "resource_group_name" {
+  value = provider::azname::generate_name("azurerm_resource_group", {
+    name        = "myapp"
+    environment = "prod"
+    location    = "westus2"
+    instance    = 1
+  })
+  # Returns: "rg-myapp-prod-wus2001"
+}
+
+# Global-scope resource types need random_seed or unique_from, as functions
+# must return the same name on every call
+output "storage_account_name" {
+  value = provider::azname::generate_name("azurerm_storage_account", {
+    name        = "myapp"
+    environment = "prod"
+    location    = "westus2"
+    unique_from = ["00000000-0000-0000-0000-000000000000"]
+  })
+}
+
+# Common use case: naming a set of resources in a for_each map
+locals {
+  subnets = {
+    web  = { service = "web", instance = 1 }
+    data = { service = "data", instance = 1 }
+  }
+
+  subnet_names = {
+    for key, subnet in local.subnets : key => provider::azname::generate_name("azurerm_subnet", {
+      name        = "myapp"
+      environment = "prod"
+      location    = "westus2"
+      service     = subnet.service
+      instance    = subnet.instance
+    })
+  }
+}
@@ -0,0 +1,24 @@
+terraform {
+  required_providers {
+    azname = {
+      source = "BHoggs/azname"
+    }
+  }
+}
+
+# Split an existing name into its components
+# Uses the default template unless AZNAME_TEMPLATE is set
+output "parsed" {
+  value = provider::azname::parse_name("rg-myapp-prod-wus2001", "azurerm_resource_group")
+  # Returns: { workload = "myapp", environment = "prod", location = "wus2", instance = "001", ... }
+}
+
+# Common use case: deriving tags from existing resource names
+locals {
+  parsed_name = provider::azname::parse_name("rg-myapp-prod-wus2001", "azurerm_resource_group")
+  tags = {
+    workload    = local.parsed_name.workload
+    environment = local.parsed_name.environment
+    region      = provider::azname::region_cli_name(local.parsed_name.location)
+  }
+}
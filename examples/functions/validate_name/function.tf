@@ -0,0 +1,26 @@
+terraform {
+  required_providers {
+    azname = {
+      source = "BHoggs/azname"
+    }
+  }
+}
+
+output "validation" {
+  value = provider::azname::validate_name("St-MyApp", "azurerm_storage_account")
+  # Returns: { valid = false, violations = ["azurerm_storage_account requires lowercase names", ...] }
+}
+
+# Common use case: asserting that hand-picked names comply
+resource "azname_name" "legacy_storage" {
+  name          = "legacy"
+  resource_type = "azurerm_storage_account"
+  custom_name   = "legacystorage01"
+
+  lifecycle {
+    precondition {
+      condition     = provider::azname::validate_name("legacystorage01", "azurerm_storage_account").valid
+      error_message = join(", ", provider::azname::validate_name("legacystorage01", "azurerm_storage_account").violations)
+    }
+  }
+}
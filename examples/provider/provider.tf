@@ -15,6 +15,9 @@ provider "azname" {
 
   # Trim names to fit within Azure resource length limits (default: true)
   trim_output = true
+
+  # Case normalization when the resource type doesn't require lowercase: preserve, lower or upper (default: preserve)
+  case = "preserve"
 }
 
 # Advanced provider configuration with prefixes and suffixes
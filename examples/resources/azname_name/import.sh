@@ -1,2 +1,6 @@
-# terraform import azname_name.example <id>
-terraform import azname_name.example "rg-myapp-prod-eastus2"
+# terraform import azname_name.example <resource_type>:<name>
+# The name is parsed against the active template to restore its inputs
+terraform import azname_name.example "azurerm_resource_group:rg-myapp-prod-wus2"
+
+# Names that do not follow the template are imported as custom_name
+terraform import azname_name.legacy "azurerm_resource_group:legacy-rg-01"
@@ -61,6 +61,15 @@ resource "azname_name" "legacy_db" {
   custom_name   = "legacy-sql-db-01"
 }
 
+# Legacy names that break the naming rules of the resource type need
+# skip_validation, as custom_name is validated like a generated name
+resource "azname_name" "legacy_sales_db" {
+  name            = "sales"
+  resource_type   = "azurerm_mssql_database"
+  custom_name     = "Legacy Sales DB"
+  skip_validation = true
+}
+
 # Example showing resource-level override of provider settings
 # You can override environment, separator, or other provider settings per-resource if needed
 resource "azname_name" "special_storage" {
@@ -91,3 +100,12 @@ resource "azname_name" "storage_deterministic" {
   random_seed   = 12345
   # Result will always be "stdata897" and shown in plan output
 }
+
+# Storage account with a new random suffix on demand
+# The suffix is kept in random_suffix and only redrawn when regenerate_random changes
+resource "azname_name" "storage_rotating" {
+  name              = "data"
+  resource_type     = "azurerm_storage_account"
+  location          = "eastus"
+  regenerate_random = "2024-01"
+}
@@ -0,0 +1,29 @@
+# Generate and persist the names of a workload at once
+resource "azname_names" "landing_zone" {
+  name        = "myapp"
+  environment = "prod"
+  location    = "eastus"
+
+  names = {
+    resource_group = {
+      resource_type = "azurerm_resource_group"
+    }
+    key_vault = {
+      resource_type = "azurerm_key_vault"
+    }
+    app_service = {
+      resource_type = "azurerm_linux_web_app"
+      service       = "api"
+      instance      = 1
+    }
+    legacy_db = {
+      resource_type = "azurerm_mssql_database"
+      custom_name   = "legacy-sql-db-01"
+    }
+  }
+}
+
+resource "azurerm_key_vault" "main" {
+  name = azname_names.landing_zone.results["key_vault"]
+  # ...
+}
@@ -0,0 +1,294 @@
+package nametemplate
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"terraform-provider-azname/internal/suggest"
+)
+
+// BuiltinTokens are the tokens that the provider always provides a value for.
+// Custom components cannot reuse these names.
+var BuiltinTokens = []string{
+	"prefix",
+	"parent_name",
+	"resource_type",
+	"workload",
+	"service",
+	"environment",
+	"location",
+	"suffix",
+	"instance",
+	"rand",
+}
+
+// DistinguishingTokens are the built-in tokens that differ between names of
+// the same resource type within a scope. Non-global names have no random
+// suffix, so a template needs at least one of these (or a custom component)
+// to produce unique names.
+var DistinguishingTokens = []string{
+	"workload",
+	"service",
+	"instance",
+	"parent_name",
+}
+
+var tokenNamePattern = regexp.MustCompile(`^[a-z_]+$`)
+
+// templateSegment is a piece of a name template, either literal text or a
+// token that is replaced with a value during name generation. Segments inside
+// an optional [...] group share a non-zero group number.
+type templateSegment struct {
+	literal string
+	token   string
+	filters []templateFilter
+	group   int
+}
+
+// templateFilter transforms the value of a token, such as the trunc:8 in
+// {workload|lower|trunc:8}.
+type templateFilter struct {
+	name   string
+	length int
+	fill   string
+}
+
+// FilterNames are the filters that can be applied to template tokens.
+var FilterNames = []string{"upper", "lower", "trunc", "pad"}
+
+// parseFilter parses a single filter expression such as trunc:8 or pad:3:x.
+func parseFilter(expression string) (templateFilter, error) {
+	parts := strings.Split(expression, ":")
+	filter := templateFilter{name: parts[0]}
+
+	switch filter.name {
+	case "upper", "lower":
+		if len(parts) != 1 {
+			return templateFilter{}, fmt.Errorf("filter %q takes no arguments", filter.name)
+		}
+	case "trunc", "pad":
+		if len(parts) < 2 || (filter.name == "trunc" && len(parts) > 2) || len(parts) > 3 {
+			return templateFilter{}, fmt.Errorf("invalid filter %q: expected %s:<length>", expression, filter.name)
+		}
+
+		length, err := strconv.Atoi(parts[1])
+		if err != nil || length < 1 {
+			return templateFilter{}, fmt.Errorf("invalid filter %q: length must be a positive number", expression)
+		}
+		filter.length = length
+
+		filter.fill = "0"
+		if len(parts) == 3 {
+			if utf8.RuneCountInString(parts[2]) != 1 {
+				return templateFilter{}, fmt.Errorf("invalid filter %q: padding must be a single character", expression)
+			}
+			filter.fill = parts[2]
+		}
+	default:
+		candidates := make(map[string][]string, len(FilterNames))
+		for _, name := range FilterNames {
+			candidates[name] = []string{name}
+		}
+		return templateFilter{}, fmt.Errorf("unknown filter %q%s", filter.name, suggest.DidYouMean(suggest.Closest(filter.name, candidates, 2)))
+	}
+
+	return filter, nil
+}
+
+// apply returns value transformed by the filter.
+func (f templateFilter) apply(value string) string {
+	switch f.name {
+	case "upper":
+		return strings.ToUpper(value)
+	case "lower":
+		return strings.ToLower(value)
+	case "trunc":
+		runes := []rune(value)
+		return string(runes[:min(len(runes), f.length)])
+	case "pad":
+		return strings.Repeat(f.fill, max(0, f.length-utf8.RuneCountInString(value))) + value
+	}
+	return value
+}
+
+// Template is a parsed name template.
+type Template struct {
+	raw      string
+	segments []templateSegment
+}
+
+// Parse tokenizes a template into literal and token segments. Tokens
+// are written as {name}, optionally followed by filters as in
+// {name|lower|trunc:8}, and optional groups as [...]; a group is dropped
+// from the name, including its literals, when all of its tokens are empty.
+// Braces or brackets that don't form a valid token or group are an error.
+func Parse(template string) (Template, error) {
+	parsed := Template{raw: template}
+
+	var literal strings.Builder
+	flush := func(group int) {
+		if literal.Len() > 0 {
+			parsed.segments = append(parsed.segments, templateSegment{literal: literal.String(), group: group})
+			literal.Reset()
+		}
+	}
+
+	groups := 0
+	group := 0
+	groupStart := 0
+	groupHasToken := false
+
+	for i := 0; i < len(template); i++ {
+		switch template[i] {
+		case '}':
+			return Template{}, fmt.Errorf("unexpected '}' at position %d in template %q", i+1, template)
+		case '{':
+			end := strings.IndexAny(template[i+1:], "{}")
+			if end == -1 || template[i+1+end] == '{' {
+				return Template{}, fmt.Errorf("unclosed '{' at position %d in template %q", i+1, template)
+			}
+
+			expressions := strings.Split(template[i+1:i+1+end], "|")
+			token := expressions[0]
+			if !tokenNamePattern.MatchString(token) {
+				return Template{}, fmt.Errorf("invalid token {%s} in template %q: token names may only contain lowercase letters and underscores", token, template)
+			}
+
+			var filters []templateFilter
+			for _, expression := range expressions[1:] {
+				filter, err := parseFilter(expression)
+				if err != nil {
+					return Template{}, fmt.Errorf("token {%s} in template %q: %w", token, template, err)
+				}
+				filters = append(filters, filter)
+			}
+
+			flush(group)
+			parsed.segments = append(parsed.segments, templateSegment{token: token, filters: filters, group: group})
+			groupHasToken = true
+			i += end + 1
+		case '[':
+			if group != 0 {
+				return Template{}, fmt.Errorf("nested '[' at position %d in template %q: optional groups cannot be nested", i+1, template)
+			}
+			flush(group)
+			groups++
+			group = groups
+			groupStart = i + 1
+			groupHasToken = false
+		case ']':
+			if group == 0 {
+				return Template{}, fmt.Errorf("unexpected ']' at position %d in template %q", i+1, template)
+			}
+			if !groupHasToken {
+				return Template{}, fmt.Errorf("optional group at position %d in template %q must contain a token", groupStart, template)
+			}
+			flush(group)
+			group = 0
+		default:
+			literal.WriteByte(template[i])
+		}
+	}
+	if group != 0 {
+		return Template{}, fmt.Errorf("unclosed '[' at position %d in template %q", groupStart, template)
+	}
+	flush(group)
+
+	return parsed, nil
+}
+
+// Tokens returns the names of the tokens used in the template, in order.
+func (t Template) Tokens() []string {
+	var tokens []string
+	for _, segment := range t.segments {
+		if segment.token != "" && !slices.Contains(tokens, segment.token) {
+			tokens = append(tokens, segment.token)
+		}
+	}
+	return tokens
+}
+
+// WithoutFilters returns a copy of the template with the named filters
+// removed from its tokens.
+func (t Template) WithoutFilters(names ...string) Template {
+	stripped := Template{raw: t.raw, segments: slices.Clone(t.segments)}
+	for i, segment := range stripped.segments {
+		stripped.segments[i].filters = slices.DeleteFunc(slices.Clone(segment.filters), func(filter templateFilter) bool {
+			return slices.Contains(names, filter.name)
+		})
+	}
+	return stripped
+}
+
+// Render joins the segments, replacing tokens with their filtered values.
+// Optional groups whose tokens are all empty are left out. Tokens without a value are
+// left in place.
+func (t Template) Render(values map[string]string) string {
+	filled := map[int]bool{}
+	for _, segment := range t.segments {
+		if segment.group != 0 && segment.token != "" && values[segment.token] != "" {
+			filled[segment.group] = true
+		}
+	}
+
+	var sb strings.Builder
+	for _, segment := range t.segments {
+		if segment.group != 0 && !filled[segment.group] {
+			continue
+		}
+
+		if segment.token == "" {
+			sb.WriteString(segment.literal)
+			continue
+		}
+
+		value, ok := values[segment.token]
+		if !ok {
+			value = "{" + segment.token + "}"
+		}
+		// empty tokens stay empty so separators and optional groups collapse
+		if value != "" {
+			for _, filter := range segment.filters {
+				value = filter.apply(value)
+			}
+		}
+		sb.WriteString(value)
+	}
+	return sb.String()
+}
+
+// Validate parses a template and checks that every token is either a
+// built-in token or one of the given custom components, and that the
+// template can produce unique names for resources without a random suffix.
+func Validate(template string, components []string) error {
+	parsed, err := Parse(template)
+	if err != nil {
+		return err
+	}
+
+	known := slices.Concat(BuiltinTokens, components)
+	candidates := make(map[string][]string, len(known))
+	for _, token := range known {
+		candidates[token] = []string{token}
+	}
+
+	distinguishing := false
+	for _, token := range parsed.Tokens() {
+		if !slices.Contains(known, token) {
+			return fmt.Errorf("unknown token {%s} in template %q%s", token, template, suggest.DidYouMean(suggest.Closest(token, candidates, 3)))
+		}
+		if slices.Contains(DistinguishingTokens, token) || slices.Contains(components, token) {
+			distinguishing = true
+		}
+	}
+
+	if !distinguishing {
+		return fmt.Errorf("template %q cannot produce unique names for resources without a random suffix; include at least one of {%s} or a custom component", template, strings.Join(DistinguishingTokens, "}, {"))
+	}
+
+	return nil
+}
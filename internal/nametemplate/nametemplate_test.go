@@ -0,0 +1,107 @@
+package nametemplate
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		tokens   []string
+		wantErr  bool
+	}{
+		{"Tokens and literals", "{resource_type}-{workload}-{environment}", []string{"resource_type", "workload", "environment"}, false},
+		{"Repeated token", "{workload}{workload}", []string{"workload"}, false},
+		{"Filters and groups", "{workload|lower|trunc:8}[-{instance|pad:3}]", []string{"workload", "instance"}, false},
+		{"Unclosed token", "{resource_type}~{workload", nil, true},
+		{"Unopened token", "{resource_type}~workload}", nil, true},
+		{"Nested token", "{resource_type}~{work{load}}", nil, true},
+		{"Invalid token", "{resource_type}~{Workload}", nil, true},
+		{"Unknown filter", "{workload|truncate:8}", nil, true},
+		{"Invalid filter length", "{workload|trunc:0}", nil, true},
+		{"Nested group", "[{workload}[{instance}]]", nil, true},
+		{"Group without token", "{workload}[-x]", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parsed, err := Parse(tt.template)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Expected error %v, got %v", tt.wantErr, err)
+			}
+			if err == nil && !reflect.DeepEqual(parsed.Tokens(), tt.tokens) {
+				t.Errorf("Expected tokens %v, got %v", tt.tokens, parsed.Tokens())
+			}
+		})
+	}
+}
+
+func TestRender(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		values   map[string]string
+		expected string
+	}{
+		{"Tokens", "{resource_type}-{workload}", map[string]string{"resource_type": "kv", "workload": "app"}, "kv-app"},
+		{"Filters", "{workload|upper|trunc:3}{instance|pad:3}", map[string]string{"workload": "myapp", "instance": "7"}, "MYA007"},
+		{"Empty values are not padded", "{workload}{instance|pad:3}", map[string]string{"workload": "app", "instance": ""}, "app"},
+		{"Filled group", "{workload}[-{instance}]", map[string]string{"workload": "app", "instance": "01"}, "app-01"},
+		{"Empty group", "{workload}[-{instance}]", map[string]string{"workload": "app", "instance": ""}, "app"},
+		{"Missing value", "{workload}-{team}", map[string]string{"workload": "app"}, "app-{team}"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parsed, err := Parse(tt.template)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if result := parsed.Render(tt.values); result != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestWithoutFilters(t *testing.T) {
+	parsed, err := Parse("{workload|upper|pad:6:x}")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	values := map[string]string{"workload": "app"}
+	if result := parsed.WithoutFilters("pad").Render(values); result != "APP" {
+		t.Errorf("Expected %q, got %q", "APP", result)
+	}
+	if result := parsed.Render(values); result != "xxxAPP" {
+		t.Errorf("Expected the original template to keep its filters, got %q", result)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name       string
+		template   string
+		components []string
+		wantErr    bool
+	}{
+		{"Built-in tokens", "{resource_type}~{workload}~{environment}", nil, false},
+		{"Custom component", "{resource_type}~{team}", []string{"team"}, false},
+		{"Unknown token", "{resource_type}~{team}", nil, true},
+		{"Random suffix only", "{resource_type}{rand}", nil, true},
+		{"No distinguishing token", "{resource_type}~{environment}~{location}", nil, true},
+		{"Invalid template", "{resource_type", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.template, tt.components)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
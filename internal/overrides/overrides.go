@@ -5,6 +5,8 @@ import (
 	"os"
 	"path/filepath"
 
+	"terraform-provider-azname/internal/nametemplate"
+
 	"gopkg.in/yaml.v3"
 )
 
@@ -21,6 +23,9 @@ type Overrides struct {
 
 	// Define completely new regions not in the provider
 	NewRegions map[string]NewRegionDefinition `yaml:"new_regions"`
+
+	// Override the name template used for specific resource types
+	ResourceTemplateOverrides map[string]string `yaml:"resource_template_overrides"`
 }
 
 // NewResourceDefinition defines a custom resource type with simplified schema
@@ -153,5 +158,16 @@ func validateOverrides(o *Overrides) error {
 		}
 	}
 
+	// Validate resource template overrides
+	for resourceType, template := range o.ResourceTemplateOverrides {
+		if template == "" {
+			return fmt.Errorf("resource_template_overrides[%s]: template cannot be empty", resourceType)
+		}
+		// tokens are checked against the provider's components when it is configured
+		if _, err := nametemplate.Parse(template); err != nil {
+			return fmt.Errorf("resource_template_overrides[%s]: %w", resourceType, err)
+		}
+	}
+
 	return nil
 }
@@ -235,6 +235,42 @@ func TestValidateOverrides(t *testing.T) {
 			t.Error("Expected error for missing short_name, got nil")
 		}
 	})
+
+	t.Run("Valid resource template override", func(t *testing.T) {
+		ovr := &Overrides{
+			ResourceTemplateOverrides: map[string]string{
+				"azurerm_storage_account":         "{environment}{resource_type}{workload}{rand}",
+				"azurerm_windows_virtual_machine": "{workload|trunc:8}{environment}{instance}",
+			},
+		}
+		err := validateOverrides(ovr)
+		if err != nil {
+			t.Errorf("Expected no error, got: %v", err)
+		}
+	})
+
+	t.Run("Resource template override invalid", func(t *testing.T) {
+		templates := map[string]string{
+			"empty":          "",
+			"unclosed":       "{resource_type}~{workload",
+			"unopened":       "{resource_type}~workload}",
+			"nested":         "{resource_type}~{work{load}}",
+			"invalid token":  "{resource_type}~{Workload}",
+			"unknown filter": "{resource_type}~{workload|truncate:8}",
+			"unclosed group": "{resource_type}~[{workload}",
+		}
+		for name, template := range templates {
+			ovr := &Overrides{
+				ResourceTemplateOverrides: map[string]string{
+					"azurerm_storage_account": template,
+				},
+			}
+			err := validateOverrides(ovr)
+			if err == nil {
+				t.Errorf("Expected error for %s template, got nil", name)
+			}
+		}
+	})
 }
 
 func TestDiscoverAndLoadOverrides(t *testing.T) {
@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"context"
+	"maps"
+
+	"terraform-provider-azname/internal/regions"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ datasource.DataSource = &AznameContextDataSource{}
+)
+
+// nameContextAttributeTypes is the object type of the context exported by
+// azname_context and accepted by azname_name.
+var nameContextAttributeTypes = map[string]attr.Type{
+	"name":        types.StringType,
+	"environment": types.StringType,
+	"location":    types.StringType,
+	"prefixes":    types.ListType{ElemType: types.StringType},
+	"suffixes":    types.ListType{ElemType: types.StringType},
+	"components":  types.MapType{ElemType: types.StringType},
+}
+
+func NewAznameContextDataSource() datasource.DataSource {
+	return &AznameContextDataSource{}
+}
+
+type AznameContextDataSource struct{}
+
+// AznameContextModel holds the workload inputs shared through a context.
+type AznameContextModel struct {
+	Name        types.String `tfsdk:"name"`
+	Environment types.String `tfsdk:"environment"`
+	Location    types.String `tfsdk:"location"`
+	Prefixes    types.List   `tfsdk:"prefixes"`
+	Suffixes    types.List   `tfsdk:"suffixes"`
+	Components  types.Map    `tfsdk:"components"`
+}
+
+type AznameContextDataSourceModel struct {
+	AznameContextModel
+	ID      types.String `tfsdk:"id"`
+	Context types.Object `tfsdk:"context"`
+}
+
+func (d *AznameContextDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_context"
+}
+
+func (d *AznameContextDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Data source for capturing the workload inputs shared by a set of names, to pass to azname_name as a context.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "ID of the data source, same as name.",
+				MarkdownDescription: "ID of the data source, same as `name`.",
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The workload or application name to use in the resource names.",
+				MarkdownDescription: "The workload or application name to use in the resource names.",
+			},
+			"environment": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The environment name (e.g., dev, test, prod) to use in the resource names.",
+				MarkdownDescription: "The environment name (e.g., dev, test, prod) to use in the resource names. Defaults to provider-level environment if not set.",
+			},
+			"location": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Azure region where the resources will be deployed.",
+				MarkdownDescription: "Azure region where the resources will be deployed. Will be included in the names if specified in the template.",
+			},
+			"prefixes": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				Description:         "List of prefixes to prepend to the resource names.",
+				MarkdownDescription: "List of prefixes to prepend to the resource names. These will be joined using the separator character.",
+			},
+			"suffixes": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				Description:         "List of suffixes to append to the resource names.",
+				MarkdownDescription: "List of suffixes to append to the resource names. These will be joined using the separator character.",
+			},
+			"components": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				Description:         "Map of values for custom template tokens, merged over the provider's components.",
+				MarkdownDescription: "Map of values for custom template tokens, merged over the provider's `components`.",
+			},
+			"context": schema.ObjectAttribute{
+				Computed:            true,
+				AttributeTypes:      nameContextAttributeTypes,
+				Description:         "The context to pass to the context attribute of azname_name.",
+				MarkdownDescription: "The context to pass to the `context` attribute of `azname_name`. Treat it as opaque; its attributes may change between provider versions.",
+			},
+		},
+	}
+}
+
+func (d *AznameContextDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state AznameContextDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Catch unknown regions here rather than in every name using the context
+	if location := state.Location.ValueString(); location != "" {
+		if _, err := regions.GetRegionByAnyName(location); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("location"), "unknown region", err.Error())
+			return
+		}
+	}
+
+	nameContext, diags := types.ObjectValueFrom(ctx, nameContextAttributeTypes, state.AznameContextModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ID = types.StringValue(state.Name.ValueString())
+	state.Context = nameContext
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// withContext fills the inputs of a name that are not set with the values of
+// its context. Context components are merged under the name's components.
+func withContext(ctx context.Context, state AznameNameModel) (AznameNameModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if state.Context.IsNull() || state.Context.IsUnknown() {
+		return state, diags
+	}
+
+	var nameContext AznameContextModel
+	diags.Append(state.Context.As(ctx, &nameContext, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return state, diags
+	}
+
+	if state.Name.IsNull() {
+		state.Name = nameContext.Name
+	}
+	if state.Environment.IsNull() {
+		state.Environment = nameContext.Environment
+	}
+	if state.Location.IsNull() {
+		state.Location = nameContext.Location
+	}
+	if state.Prefixes.IsNull() {
+		state.Prefixes = nameContext.Prefixes
+	}
+	if state.Suffixes.IsNull() {
+		state.Suffixes = nameContext.Suffixes
+	}
+	// components only known during apply leave the merged components unknown
+	if nameContext.Components.IsUnknown() || (!nameContext.Components.IsNull() && state.Components.IsUnknown()) {
+		state.Components = types.MapUnknown(types.StringType)
+	} else if !nameContext.Components.IsNull() {
+		components := maps.Clone(nameContext.Components.Elements())
+		if !state.Components.IsNull() {
+			maps.Copy(components, state.Components.Elements())
+		}
+		state.Components = types.MapValueMust(types.StringType, components)
+	}
+
+	return state, diags
+}
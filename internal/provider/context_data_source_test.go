@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestContextDataSource(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+					data "azname_context" "workload" {
+						name        = "myapp"
+						environment = "prod"
+						location    = "eastus"
+					}
+					data "azname_name" "rg" {
+						resource_type = "azurerm_resource_group"
+						context       = data.azname_context.workload.context
+					}
+					# Attributes set on the name override the context
+					data "azname_name" "dev" {
+						resource_type = "azurerm_resource_group"
+						environment   = "dev"
+						context       = data.azname_context.workload.context
+					}
+					resource "azname_name" "vnet" {
+						resource_type = "azurerm_virtual_network"
+						context       = data.azname_context.workload.context
+					}
+					`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.azname_context.workload", "context.name", "myapp"),
+					resource.TestCheckResourceAttr("data.azname_name.rg", "result", "azname-rg-myapp-prod-eus"),
+					resource.TestCheckResourceAttr("data.azname_name.dev", "result", "azname-rg-myapp-dev-eus"),
+					resource.TestCheckResourceAttr("azname_name.vnet", "result", "azname-vnet-myapp-prod-eus"),
+				),
+			},
+		},
+	})
+}
+
+func TestContextDataSourceMissingName(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+					data "azname_context" "shared" {
+						environment = "prod"
+					}
+					data "azname_name" "rg" {
+						resource_type = "azurerm_resource_group"
+						context       = data.azname_context.shared.context
+					}
+					`,
+				ExpectError: regexp.MustCompile(`Missing name`),
+			},
+			{
+				Config: providerConfig + `
+					data "azname_name" "rg" {
+						resource_type = "azurerm_resource_group"
+					}
+					`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
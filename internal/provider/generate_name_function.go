@@ -0,0 +1,287 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"math/big"
+	"slices"
+	"strings"
+
+	"terraform-provider-azname/internal/suggest"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ function.Function = GenerateNameFunction{}
+)
+
+// generateNameOptions are the attributes accepted in the options object of
+// generate_name, matching the inputs of the azname_name data source.
+var generateNameOptions = []string{
+	"name", "environment", "prefixes", "suffixes", "separator", "random_seed", "location", "instance", "service",
+	"parent_name", "truncate_strategy", "template_name", "template", "components", "random_charset", "unique_from", "random",
+}
+
+func NewGenerateNameFunction() function.Function {
+	return GenerateNameFunction{}
+}
+
+type GenerateNameFunction struct{}
+
+func (r GenerateNameFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "generate_name"
+}
+
+func (r GenerateNameFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Generate a name",
+		MarkdownDescription: `Generates a name for a resource type like the ` + "`azname_name`" + ` data source, so names can be computed inline in ` + "`locals`" + `, ` + "`for_each`" + ` maps and module outputs.
+The options object takes the same inputs as the data source, such as ` + "`name`" + ` (required), ` + "`environment`" + `, ` + "`location`" + `, ` + "`service`" + `, ` + "`instance`" + ` and ` + "`components`" + `.
+
+Terraform calls provider functions without the provider configuration, so templates and defaults are read from the ` + "`AZNAME_*`" + ` environment variables. Functions must return the same result on every call, so names with a random suffix, such as global-scope resource types, require ` + "`random_seed`" + ` or ` + "`unique_from`" + `.`,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "resource_type",
+				MarkdownDescription: "Resource type of the name, e.g. 'azurerm_resource_group', 'rg' or 'Microsoft.Resources/resourceGroups'",
+			},
+			function.DynamicParameter{
+				Name:                "options",
+				MarkdownDescription: "Object with the inputs of the name, e.g. '{ name = \"myapp\", environment = \"prod\", location = \"eastus\" }'",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (r GenerateNameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var resourceType string
+	var options types.Dynamic
+
+	resp.Error = req.Arguments.Get(ctx, &resourceType, &options)
+	if resp.Error != nil {
+		return
+	}
+
+	model, err := optionsModel(resourceType, options)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	config, diags := providerDefaults(ctx)
+	if diags.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+
+	needsRandom, diags := NeedsRandomGeneration(ctx, model)
+	if diags.HasError() {
+		resp.Error = funcErrorFromNameDiags(diags)
+		return
+	}
+	if needsRandom {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("%s names have a random suffix, which requires random_seed or unique_from in a function; alternatively set random to \"never\"", resourceType))
+		return
+	}
+
+	result, diags := GenerateName(ctx, model, config)
+	if diags.HasError() {
+		resp.Error = funcErrorFromNameDiags(diags)
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// funcErrorFromNameDiags converts the errors of generating a name to a
+// function error on the argument they relate to.
+func funcErrorFromNameDiags(diags diag.Diagnostics) *function.FuncError {
+	var funcErr *function.FuncError
+	for _, d := range diags.Errors() {
+		argument := int64(1)
+		if d, ok := d.(diag.DiagnosticWithPath); ok && d.Path().Equal(path.Root("resource_type")) {
+			argument = 0
+		}
+		funcErr = function.ConcatFuncErrors(funcErr, function.NewArgumentFuncError(argument, d.Detail()))
+	}
+	return funcErr
+}
+
+// optionsModel converts the options object of generate_name to a name model.
+func optionsModel(resourceType string, options types.Dynamic) (AznameNameModel, error) {
+	model := newNameModel(resourceType)
+
+	var attributes map[string]attr.Value
+	switch value := options.UnderlyingValue().(type) {
+	case types.Object:
+		attributes = value.Attributes()
+	case types.Map:
+		attributes = value.Elements()
+	default:
+		return model, fmt.Errorf("options must be an object")
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(attributes)) {
+		value := attributes[key]
+		if !slices.Contains(generateNameOptions, key) {
+			candidates := make(map[string][]string, len(generateNameOptions))
+			for _, name := range generateNameOptions {
+				candidates[name] = []string{name}
+			}
+			return model, fmt.Errorf("unknown option %q%s", key, suggest.DidYouMean(suggest.Closest(key, candidates, 2)))
+		}
+		if value.IsNull() {
+			continue
+		}
+
+		var err error
+		switch key {
+		case "name":
+			model.Name, err = optionString(value)
+		case "environment":
+			model.Environment, err = optionString(value)
+		case "prefixes":
+			model.Prefixes, err = optionList(value)
+		case "suffixes":
+			model.Suffixes, err = optionList(value)
+		case "separator":
+			model.Separator, err = optionString(value)
+		case "random_seed":
+			model.RandomSeed, err = optionInt(value)
+		case "location":
+			model.Location, err = optionString(value)
+		case "instance":
+			model.Instance, err = optionInt(value)
+		case "service":
+			model.Service, err = optionString(value)
+		case "parent_name":
+			model.ParentName, err = optionString(value)
+		case "truncate_strategy":
+			model.TruncateStrategy, err = optionString(value)
+		case "template_name":
+			model.TemplateName, err = optionString(value)
+		case "template":
+			model.Template, err = optionString(value)
+		case "components":
+			model.Components, err = optionMap(value)
+		case "random_charset":
+			model.RandomCharset, err = optionString(value)
+		case "unique_from":
+			model.UniqueFrom, err = optionList(value)
+		case "random":
+			model.Random, err = optionString(value)
+		}
+		if err != nil {
+			return model, fmt.Errorf("option %q %w", key, err)
+		}
+	}
+
+	return model, validateOptions(model)
+}
+
+// validateOptions applies the checks of the azname_name schema validators.
+func validateOptions(model AznameNameModel) error {
+	switch {
+	case model.Name.IsNull():
+		return fmt.Errorf("option \"name\" is required")
+	case len(model.Separator.ValueString()) > 1:
+		return fmt.Errorf("option \"separator\" must be at most 1 character")
+	case !model.Template.IsNull() && !model.TemplateName.IsNull():
+		return fmt.Errorf("option \"template\" conflicts with \"template_name\"")
+	case !model.UniqueFrom.IsNull() && len(model.UniqueFrom.Elements()) == 0:
+		return fmt.Errorf("option \"unique_from\" must contain at least 1 element")
+	case !model.UniqueFrom.IsNull() && !model.RandomSeed.IsNull():
+		return fmt.Errorf("option \"unique_from\" conflicts with \"random_seed\"")
+	}
+
+	for _, choice := range []struct {
+		key     string
+		value   types.String
+		allowed []string
+	}{
+		{"truncate_strategy", model.TruncateStrategy, []string{"segment", "hash"}},
+		{"random_charset", model.RandomCharset, randomCharsetNames},
+		{"random", model.Random, []string{"auto", "always", "never"}},
+	} {
+		if !choice.value.IsNull() && !slices.Contains(choice.allowed, choice.value.ValueString()) {
+			return fmt.Errorf("option %q must be one of %s, got %q", choice.key, strings.Join(choice.allowed, ", "), choice.value.ValueString())
+		}
+	}
+
+	return nil
+}
+
+// optionString converts an option value to a string.
+func optionString(value attr.Value) (types.String, error) {
+	if value, ok := value.(types.String); ok {
+		return value, nil
+	}
+	return types.StringNull(), fmt.Errorf("must be a string")
+}
+
+// optionInt converts an option value to a whole number.
+func optionInt(value attr.Value) (types.Int64, error) {
+	var number *big.Float
+	switch value := value.(type) {
+	case types.Number:
+		number = value.ValueBigFloat()
+	case types.Int64:
+		return value, nil
+	default:
+		return types.Int64Null(), fmt.Errorf("must be a number")
+	}
+
+	result, accuracy := number.Int64()
+	if accuracy != big.Exact {
+		return types.Int64Null(), fmt.Errorf("must be a whole number")
+	}
+	return types.Int64Value(result), nil
+}
+
+// optionList converts a list, tuple or set option value to a list of strings.
+func optionList(value attr.Value) (types.List, error) {
+	var elements []attr.Value
+	switch value := value.(type) {
+	case types.Tuple:
+		elements = value.Elements()
+	case types.List:
+		elements = value.Elements()
+	case types.Set:
+		elements = value.Elements()
+	default:
+		return types.ListNull(types.StringType), fmt.Errorf("must be a list of strings")
+	}
+
+	for _, element := range elements {
+		if _, ok := element.(types.String); !ok {
+			return types.ListNull(types.StringType), fmt.Errorf("must be a list of strings")
+		}
+	}
+	return types.ListValueMust(types.StringType, elements), nil
+}
+
+// optionMap converts an object or map option value to a map of strings.
+func optionMap(value attr.Value) (types.Map, error) {
+	var elements map[string]attr.Value
+	switch value := value.(type) {
+	case types.Object:
+		elements = value.Attributes()
+	case types.Map:
+		elements = value.Elements()
+	default:
+		return types.MapNull(types.StringType), fmt.Errorf("must be a map of strings")
+	}
+
+	for _, element := range elements {
+		if _, ok := element.(types.String); !ok {
+			return types.MapNull(types.StringType), fmt.Errorf("must be a map of strings")
+		}
+	}
+	return types.MapValueMust(types.StringType, elements), nil
+}
@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestGenerateNameFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "resource_group" {
+					value = provider::azname::generate_name("azurerm_resource_group", {
+						name        = "myapp"
+						environment = "prod"
+						location    = "eastus"
+						instance    = 1
+					})
+				}
+				output "storage_account" {
+					value = provider::azname::generate_name("st", {
+						name        = "myapp"
+						environment = "prod"
+						location    = "eastus"
+						random_seed = 999
+					})
+				}
+				output "unique_from" {
+					value = provider::azname::generate_name("st", {
+						name        = "myapp"
+						environment = "prod"
+						location    = "eastus"
+						unique_from = ["00000000-0000-0000-0000-000000000000"]
+					})
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("resource_group", "rg-myapp-prod-eus001"),
+					resource.TestCheckOutput("storage_account", "stmyappprodeus415"),
+					resource.TestCheckOutput("unique_from", "stmyappprodeus569"),
+				),
+			},
+		},
+	})
+}
+
+func TestGenerateNameFunction_Errors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unseeded random suffixes would differ on every call
+			{
+				Config: `
+				output "test" {
+					value = provider::azname::generate_name("azurerm_storage_account", { name = "myapp" })
+				}
+				`,
+				ExpectError: regexp.MustCompile(`requires random_seed or unique_from`),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::azname::generate_name("azurerm_resource_group", { name = "myapp", enviroment = "prod" })
+				}
+				`,
+				ExpectError: regexp.MustCompile(`unknown option "enviroment"`),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::azname::generate_name("azurerm_resource_group", { environment = "prod" })
+				}
+				`,
+				ExpectError: regexp.MustCompile(`option "name" is required`),
+			},
+		},
+	})
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -68,9 +70,18 @@ func (d *AznameDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				MarkdownDescription: "The generated resource name following the configured template pattern.",
 			},
 			"name": schema.StringAttribute{
-				Required:            true,
-				Description:         "The workload or application name to use in the resource name.",
-				MarkdownDescription: "The workload or application name to use in the resource name.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.AtLeastOneOf(path.MatchRoot("context")),
+				},
+				Description:         "The workload or application name to use in the resource name. Required unless context provides it.",
+				MarkdownDescription: "The workload or application name to use in the resource name. Required unless `context` provides it.",
+			},
+			"context": schema.ObjectAttribute{
+				Optional:            true,
+				AttributeTypes:      nameContextAttributeTypes,
+				Description:         "Context from an azname_context data source providing the shared workload inputs.",
+				MarkdownDescription: "The `context` of an `azname_context` data source, providing `name`, `environment`, `location`, `prefixes`, `suffixes` and `components` for inputs that are not set on this name. Components of the context are merged under `components`.",
 			},
 			"environment": schema.StringAttribute{
 				Optional:            true,
@@ -80,12 +91,17 @@ func (d *AznameDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 			"custom_name": schema.StringAttribute{
 				Optional:            true,
 				Description:         "Override the generated name with a custom value. Useful for legacy or imported resources.",
-				MarkdownDescription: "Override the generated name with a custom value. Useful for legacy or imported resources.",
+				MarkdownDescription: "Override the generated name with a custom value. Useful for legacy or imported resources. The name is checked against the length, character and pattern rules of the resource type unless `skip_validation` is set.",
+			},
+			"skip_validation": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Skip the resource type naming rules when checking custom_name.",
+				MarkdownDescription: "Skip the resource type naming rules when checking `custom_name`. Use this for legacy names that predate the naming convention and break its rules. Default: false",
 			},
 			"resource_type": schema.StringAttribute{
 				Required:            true,
-				Description:         "The Azure resource type abbreviation (e.g., rg for resource group, kv for key vault).",
-				MarkdownDescription: "The Azure resource type abbreviation (e.g., rg for resource group, kv for key vault).",
+				Description:         "The Azure resource type, as an azurerm type name (e.g., azurerm_key_vault), CAF slug (e.g., kv) or resource provider namespace (e.g., Microsoft.KeyVault/vaults).",
+				MarkdownDescription: "The Azure resource type, as an azurerm type name (e.g., `azurerm_key_vault`), CAF slug (e.g., `kv`) or Azure resource provider namespace (e.g., `Microsoft.KeyVault/vaults`). Slugs and namespaces shared by several resource types are rejected with a list of candidates; use the azurerm type name in that case.",
 			},
 			"prefixes": schema.ListAttribute{
 				Optional:            true,
@@ -132,6 +148,59 @@ func (d *AznameDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				Description:         "Name of the parent resource for child resources.",
 				MarkdownDescription: "Name of the parent resource. Required when generating names for child resources.",
 			},
+			"truncate_strategy": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("segment", "hash"),
+				},
+				Description:         "How to shorten the name if it exceeds the maximum length. Defaults to provider's truncate_strategy setting.",
+				MarkdownDescription: "How to shorten the name if it exceeds the resource type maximum length: `segment` or `hash`. Defaults to provider's `truncate_strategy` setting.",
+			},
+			"template_name": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Name of a template from the provider's templates map to generate the name with.",
+				MarkdownDescription: "Name of a template from the provider's `templates` map to generate the name with. Defaults to the provider's `template`, or `template_child` when `parent_name` is set.",
+			},
+			"template": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("template_name")),
+				},
+				Description:         "Inline template to generate the name with, instead of the provider templates.",
+				MarkdownDescription: "Inline template to generate the name with, instead of the provider templates. Uses ~ as a placeholder for the separator character. Conflicts with `template_name`.",
+			},
+			"random": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("auto", "always", "never"),
+				},
+				Description:         "Whether to generate the random suffix: auto, always or never. Default: auto",
+				MarkdownDescription: "Whether to generate the `{rand}` suffix: `auto` (default) only for global-scope resource types, `always` for any resource type, such as blue/green deployments in the same resource group, or `never`.",
+			},
+			"unique_from": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ConflictsWith(path.MatchRoot("random_seed")),
+				},
+				Description:         "Values to derive a stable random suffix from, such as a subscription or resource group ID.",
+				MarkdownDescription: "Values to derive a stable random suffix from, such as a subscription, resource group or tenant ID, similar to ARM's `uniqueString()`. The same values always produce the same suffix, so global-scope names are known at plan time. Values are compared case-insensitively. Conflicts with `random_seed`.",
+			},
+			"random_charset": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(randomCharsetNames...),
+				},
+				Description:         "Characters to draw the random suffix from. Defaults to provider's random_charset setting.",
+				MarkdownDescription: "Characters to draw the `{rand}` suffix from: `numeric`, `hex`, `alnum` or `crockford`. Defaults to provider's `random_charset` setting.",
+			},
+			"components": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				Description:         "Map of values for custom template tokens, merged over the provider's components.",
+				MarkdownDescription: "Map of values for custom template tokens, merged over the provider's `components`. A key of `team` provides the value for a `{team}` token. Templates referencing a token without a value are rejected.",
+			},
 		},
 	}
 }
@@ -149,6 +218,11 @@ func (d *AznameDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 
 	// If a custom_name is provided, use that as the result
 	if !state.CustomName.IsNull() {
+		resp.Diagnostics.Append(checkCustomName(state.AznameNameModel)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
 		state.Result = state.CustomName
 		state.ID = state.CustomName
 		resp.State.Set(ctx, state)
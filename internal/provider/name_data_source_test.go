@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -197,3 +198,524 @@ func TestNameDataSourceWithProviderLocation(t *testing.T) {
 		},
 	})
 }
+
+func TestNameDataSourceCase(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Resource types that require lowercase are lowercased before cleaning
+			{
+				Config: `
+					provider "azname" {
+						random_length = 3
+					}
+					data "azname_name" "storage" {
+						name          = "MyApp"
+						environment   = "Prod"
+						resource_type = "azurerm_storage_account"
+						location      = "Australia East"
+						random_seed   = 123
+					}
+					`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.azname_name.storage", "result", "stmyappprodae851"),
+				),
+			},
+			// Provider case mode applies when the resource type has no opinion
+			{
+				Config: `
+					provider "azname" {
+						random_length = 3
+						case          = "upper"
+					}
+					data "azname_name" "rg" {
+						name          = "MyApp"
+						resource_type = "azurerm_resource_group"
+						location      = "Australia East"
+					}
+					`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.azname_name.rg", "result", "RG-MYAPP-AE"),
+				),
+			},
+		},
+	})
+}
+
+func TestNameDataSourceMinLength(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Names shorter than the resource type minimum length are rejected
+			{
+				Config: `
+					provider "azname" {
+						random_length = 3
+					}
+					data "azname_name" "aa" {
+						name          = "ab"
+						resource_type = "azurerm_automation_account"
+					}
+					`,
+				ExpectError: regexp.MustCompile("Generated name too short"),
+			},
+			// Filler padding extends the name to the minimum length
+			{
+				Config: `
+					provider "azname" {
+						random_length = 3
+						pad_strategy  = "filler"
+					}
+					data "azname_name" "aa" {
+						name          = "ab"
+						resource_type = "azurerm_automation_account"
+					}
+					`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.azname_name.aa", "result", "aa-ab-0"),
+				),
+			},
+		},
+	})
+}
+
+func TestNameDataSourceTruncation(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The workload is shortened while location, instance and random suffix are kept
+			{
+				Config: `
+					provider "azname" {
+						random_length = 3
+					}
+					data "azname_name" "kv" {
+						name          = "averyverylongworkloadname"
+						environment   = "prod"
+						resource_type = "azurerm_key_vault"
+						location      = "Australia East"
+						instance      = 1
+						random_seed   = 123
+					}
+					`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.azname_name.kv", "result", "kv-averyve-prod-ae001851"),
+				),
+			},
+			// Once the workload is exhausted the service is shortened next
+			{
+				Config: `
+					provider "azname" {
+						random_length = 3
+					}
+					data "azname_name" "kv" {
+						name          = "averyverylongworkloadname"
+						environment   = "prod"
+						service       = "frontend"
+						resource_type = "azurerm_key_vault"
+						location      = "Australia East"
+						instance      = 1
+						random_seed   = 123
+					}
+					`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.azname_name.kv", "result", "kv-a-prod-front-ae001851"),
+				),
+			},
+			// pad filters would refill the shortened workload, so they are dropped
+			{
+				Config: `
+					provider "azname" {
+						random_length = 3
+					}
+					data "azname_name" "kv" {
+						name          = "averyverylongworkloadname"
+						environment   = "prod"
+						resource_type = "azurerm_key_vault"
+						location      = "Australia East"
+						template      = "{resource_type}~{workload|pad:30:x}~{environment}~{location}{rand}"
+						random_seed   = 123
+					}
+					`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.azname_name.kv", "result", "kv-averyveryl-prod-ae851"),
+				),
+			},
+			// Long literals are cut without leaving a separator, keeping the random suffix
+			{
+				Config: `
+					provider "azname" {
+						random_length = 3
+					}
+					data "azname_name" "kv" {
+						name          = "app"
+						environment   = "prod"
+						resource_type = "azurerm_key_vault"
+						template      = "{resource_type}~{workload}abcdefghijklmnop~{environment}{rand}"
+						random_seed   = 123
+					}
+					`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.azname_name.kv", "result", "kv-aabcdefghijklmnop851"),
+				),
+			},
+			// The random suffix keeps its place in the template when the name is cut
+			{
+				Config: `
+					provider "azname" {
+						random_length = 3
+					}
+					data "azname_name" "kv" {
+						name          = "app"
+						environment   = "prod"
+						resource_type = "azurerm_key_vault"
+						template      = "{resource_type}~{rand}~{workload}abcdefghijklmnop~{environment}"
+						random_seed   = 123
+					}
+					`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.azname_name.kv", "result", "kv-851-aabcdefghijklmnop"),
+				),
+			},
+		},
+	})
+}
+
+func TestNameDataSourceHashTruncation(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Inputs that share a long common prefix stay distinct after truncation
+			{
+				Config: `
+					provider "azname" {
+						random_length     = 3
+						truncate_strategy = "hash"
+					}
+					data "azname_name" "one" {
+						name          = "averyverylongworkloadname"
+						environment   = "prod"
+						resource_type = "azurerm_key_vault"
+						location      = "Australia East"
+						random_seed   = 123
+					}
+					data "azname_name" "two" {
+						name          = "averyverylongworkloadname2"
+						environment   = "prod"
+						resource_type = "azurerm_key_vault"
+						location      = "Australia East"
+						random_seed   = 123
+					}
+					data "azname_name" "storage" {
+						name              = "averyverylongworkloadname"
+						resource_type     = "azurerm_storage_account"
+						random_seed       = 123
+						truncate_strategy = "hash"
+					}
+					`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.azname_name.one", "result", "kv-averyverylongw-186d6e"),
+					resource.TestCheckResourceAttr("data.azname_name.two", "result", "kv-averyverylongw-28e561"),
+					resource.TestCheckResourceAttr("data.azname_name.storage", "result", "staveryverylongwor1e1e8a"),
+				),
+			},
+		},
+	})
+}
+
+func TestNameDataSourceResourceTypeLookup(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Resource types can be given by slug or resource provider namespace
+			{
+				Config: `
+					provider "azname" {
+						random_length = 3
+					}
+					data "azname_name" "slug" {
+						name          = "myapp"
+						resource_type = "rg"
+						location      = "Australia East"
+					}
+					data "azname_name" "namespace" {
+						name          = "myapp"
+						resource_type = "Microsoft.KeyVault/vaults"
+						location      = "Australia East"
+						random_seed   = 123
+					}
+					`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.azname_name.slug", "result", "rg-myapp-ae"),
+					resource.TestCheckResourceAttr("data.azname_name.namespace", "result", "kv-myapp-ae851"),
+				),
+			},
+			// Slugs shared by several resource types are rejected
+			{
+				Config: `
+					provider "azname" {
+						random_length = 3
+					}
+					data "azname_name" "vm" {
+						name          = "myapp"
+						resource_type = "vm"
+					}
+					`,
+				ExpectError: regexp.MustCompile("ambiguous resource type"),
+			},
+		},
+	})
+}
+
+func TestNameDataSourceComponents(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Custom tokens resolve from provider defaults, overridden by resource components
+			{
+				Config: `
+					provider "azname" {
+						random_length = 3
+						template      = "{resource_type}~{team}~{workload}~{tier}"
+						components = {
+							team = "plat"
+							tier = "app"
+						}
+					}
+					data "azname_name" "rg" {
+						name          = "myapp"
+						resource_type = "azurerm_resource_group"
+						components = {
+							tier = "web"
+						}
+					}
+					`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.azname_name.rg", "result", "rg-plat-myapp-web"),
+				),
+			},
+			// Tokens without a value are reported instead of left in the name
+			{
+				Config: `
+					provider "azname" {
+						random_length = 3
+					}
+					data "azname_name" "rg" {
+						name          = "myapp"
+						resource_type = "azurerm_resource_group"
+						template      = "{resource_type}~{workload}~{tier}"
+					}
+					`,
+				ExpectError: regexp.MustCompile(`references \{tier\}`),
+			},
+		},
+	})
+}
+
+func TestNameDataSourceTemplateValidation(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unknown tokens are rejected with a suggestion
+			{
+				Config: `
+					provider "azname" {
+						template = "{prefx}~{workload}"
+					}
+					data "azname_name" "rg" {
+						name          = "myapp"
+						resource_type = "azurerm_resource_group"
+					}
+					`,
+				ExpectError: regexp.MustCompile(`unknown token \{prefx\}.*did you mean prefix`),
+			},
+			// Malformed tokens are rejected
+			{
+				Config: `
+					provider "azname" {
+						template_child = "{parent_name}~{resource_type"
+					}
+					data "azname_name" "rg" {
+						name          = "myapp"
+						resource_type = "azurerm_resource_group"
+					}
+					`,
+				ExpectError: regexp.MustCompile(`unclosed '\{'`),
+			},
+			// Templates must be able to tell names of the same resource type apart
+			{
+				Config: `
+					provider "azname" {
+						templates = {
+							shared = "{resource_type}~{environment}~{location}{rand}"
+						}
+					}
+					data "azname_name" "rg" {
+						name          = "myapp"
+						resource_type = "azurerm_resource_group"
+					}
+					`,
+				ExpectError: regexp.MustCompile(`cannot produce unique names`),
+			},
+		},
+	})
+}
+
+func TestNameDataSourceOptionalGroups(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Literals in an optional group are dropped along with an empty token
+			{
+				Config: `
+					provider "azname" {
+						random_length = 3
+						template      = "{resource_type}~{workload}[~vm{instance}]"
+					}
+					data "azname_name" "without" {
+						name          = "myapp"
+						resource_type = "azurerm_resource_group"
+					}
+					data "azname_name" "with" {
+						name          = "myapp"
+						resource_type = "azurerm_resource_group"
+						instance      = 1
+					}
+					`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.azname_name.without", "result", "rg-myapp"),
+					resource.TestCheckResourceAttr("data.azname_name.with", "result", "rg-myapp-vm001"),
+				),
+			},
+		},
+	})
+}
+
+func TestNameDataSourceTokenFilters(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Filters are applied per token before the provider case
+			{
+				Config: `
+					provider "azname" {
+						template = "{resource_type}~{workload|lower|trunc:5}~{environment|upper|trunc:1}[~{service|pad:4:x}]"
+					}
+					data "azname_name" "test" {
+						name          = "MyApplication"
+						resource_type = "azurerm_application_gateway"
+						environment   = "prod"
+						service       = "ab"
+					}
+					data "azname_name" "empty" {
+						name          = "MyApplication"
+						resource_type = "azurerm_application_gateway"
+						environment   = "prod"
+					}
+					`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.azname_name.test", "result", "agw-myapp-P-xxab"),
+					resource.TestCheckResourceAttr("data.azname_name.empty", "result", "agw-myapp-P"),
+				),
+			},
+			// Invalid filters are rejected when the provider is configured
+			{
+				Config: `
+					provider "azname" {
+						template = "{resource_type}~{workload|lowr}"
+					}
+					data "azname_name" "test" {
+						name          = "myapp"
+						resource_type = "azurerm_resource_group"
+					}
+					`,
+				ExpectError: regexp.MustCompile(`unknown filter "lowr"`),
+			},
+		},
+	})
+}
+
+func TestNameDataSourceRandomCharset(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					provider "azname" {
+						random_length  = 3
+						environment    = "prod"
+						random_charset = "hex"
+					}
+					data "azname_name" "hex" {
+						name          = "myapp"
+						resource_type = "azurerm_storage_account"
+						location      = "eastus"
+						random_seed   = 999
+					}
+					data "azname_name" "crockford" {
+						name           = "myapp"
+						resource_type  = "azurerm_app_service"
+						location       = "eastus"
+						random_seed    = 999
+						random_charset = "crockford"
+					}
+					data "azname_name" "crockford_lower" {
+						name           = "myapp"
+						resource_type  = "azurerm_storage_account"
+						location       = "eastus"
+						random_seed    = 999
+						random_charset = "crockford"
+					}
+					`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.azname_name.hex", "result", "stmyappprodeus644"),
+					resource.TestCheckResourceAttr("data.azname_name.crockford", "result", "app-myapp-prod-eusPMM"),
+					// storage accounts require lowercase names
+					resource.TestCheckResourceAttr("data.azname_name.crockford_lower", "result", "stmyappprodeuspmm"),
+				),
+			},
+		},
+	})
+}
+
+func TestNameDataSourceCustomNameValidation(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+					data "azname_name" "test" {
+						name          = "myapp"
+						resource_type = "azurerm_key_vault"
+						custom_name   = "kv-legacy-name-that-is-far-too-long"
+					}
+					`,
+				ExpectError: regexp.MustCompile(`Invalid custom name`),
+			},
+			{
+				Config: providerConfig + `
+					data "azname_name" "test" {
+						name            = "myapp"
+						resource_type   = "azurerm_key_vault"
+						custom_name     = "kv-legacy-name-that-is-far-too-long"
+						skip_validation = true
+					}
+					`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.azname_name.test", "result", "kv-legacy-name-that-is-far-too-long"),
+				),
+			},
+		},
+	})
+}
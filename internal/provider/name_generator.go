@@ -2,12 +2,22 @@ package provider
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
 	"fmt"
+	"hash/fnv"
+	"maps"
 	"math"
 	"math/rand/v2"
 	"regexp"
+	"slices"
+	"strconv"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 
+	"terraform-provider-azname/internal/nametemplate"
 	"terraform-provider-azname/internal/regions"
 	"terraform-provider-azname/internal/resources"
 
@@ -16,6 +26,20 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// hashSuffixLength is the number of hash characters appended to names
+// truncated with the hash strategy.
+const hashSuffixLength = 6
+
+// randomCharsetNames are the accepted values of random_charset.
+var randomCharsetNames = []string{"numeric", "hex", "alnum", "crockford"}
+
+// randomCharsets maps each non-numeric random_charset to its alphabet.
+var randomCharsets = map[string]string{
+	"hex":       "0123456789abcdef",
+	"alnum":     "0123456789abcdefghijklmnopqrstuvwxyz",
+	"crockford": "0123456789ABCDEFGHJKMNPQRSTVWXYZ",
+}
+
 // Helper function to convert a Terraform list to a Go slice.
 func convertFromTfList[T any](ctx context.Context, list types.List) ([]T, error) {
 	var result []T
@@ -39,8 +63,9 @@ func convertFromTfList[T any](ctx context.Context, list types.List) ([]T, error)
 }
 
 // NeedsRandomGeneration checks if a resource type requires random generation
-// and whether a random_seed has been provided. Returns true if randomness is
-// needed but no seed is provided, indicating the result should be unknown during plan.
+// and whether a random_seed or unique_from has been provided. Returns true if
+// randomness is needed but no seed is known, indicating the result should be
+// unknown during plan.
 func NeedsRandomGeneration(ctx context.Context, state AznameNameModel) (bool, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
@@ -50,62 +75,111 @@ func NeedsRandomGeneration(ctx context.Context, state AznameNameModel) (bool, di
 		return false, diags
 	}
 
-	// If the resource is global scope and no random_seed is provided, we need random generation
-	if resourceType.Scope == "global" && state.RandomSeed.IsNull() {
-		return true, diags
+	if !usesRandom(state, resourceType) || !state.RandomSeed.IsNull() {
+		return false, diags
+	}
+
+	// unique_from seeds the suffix as long as all of its values are known
+	if !state.UniqueFrom.IsNull() {
+		if state.UniqueFrom.IsUnknown() {
+			return true, diags
+		}
+		for _, value := range state.UniqueFrom.Elements() {
+			if value.IsUnknown() {
+				return true, diags
+			}
+		}
+		return false, diags
 	}
 
-	return false, diags
+	// If the name has a random suffix and no seed is provided, we need random generation
+	return true, diags
 }
 
 func GenerateName(ctx context.Context, state AznameNameModel, config AznameProviderModel) (string, diag.Diagnostics) {
-	var diags diag.Diagnostics
+	result, _, diags := generateName(ctx, state, config, "")
+	return result, diags
+}
+
+// generateName generates a name like GenerateName, using randomSuffix for an
+// unseeded {rand} token when it still matches random_length and random_charset. It also
+// returns the random suffix of the name so that it can be stored and reused
+// when the name is regenerated.
+func generateName(ctx context.Context, state AznameNameModel, config AznameProviderModel, randomSuffix string) (string, string, diag.Diagnostics) {
+	state, diags := withContext(ctx, state)
+	if diags.HasError() {
+		return "", "", diags
+	}
+
+	if state.Name.IsNull() {
+		diags.AddAttributeError(path.Root("name"), "Missing name", "Set name, or pass a context from an azname_context data source with a name.")
+		return "", "", diags
+	}
 
 	resourceType, err := resources.GetResourceDefinition(state.ResourceType.ValueString())
 	if err != nil {
 		diags.AddAttributeError(path.Root("resource_type"), "unknown resource type", err.Error())
-		return "", diags
+		return "", "", diags
 	}
 
+	var rng *rand.Rand
 	var randomSuffixString string
-	if resourceType.Scope == "global" {
-		var rng *rand.Rand
-		if !state.RandomSeed.IsNull() {
+	if usesRandom(state, resourceType) {
+		switch {
+		case !state.RandomSeed.IsNull():
 			seed := uint64(state.RandomSeed.ValueInt64())
 			rng = rand.New(rand.NewPCG(seed, seed))
-		} else {
+		case !state.UniqueFrom.IsNull():
+			uniqueFrom, err := convertFromTfList[string](ctx, state.UniqueFrom)
+			if err != nil {
+				diags.AddError("Error extracting unique_from", err.Error())
+				return "", "", diags
+			}
+			rng = rand.New(rand.NewPCG(uniqueSeed(uniqueFrom)))
+		default:
 			rng = rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64()))
 		}
+		charset := randomCharset(state, config)
 		randomLength := int(config.RandomLength.ValueInt64())
-		randomSuffix := rng.IntN(int(math.Pow10(randomLength) - 1))
-		randomSuffixString = fmt.Sprintf("%0*d", randomLength, randomSuffix)
+		randomSuffixString = randomString(charset, randomLength, rng)
+		// seeded suffixes are deterministic, only unseeded ones are reused
+		if state.RandomSeed.IsNull() && state.UniqueFrom.IsNull() {
+			if matchesCharset(randomSuffix, charset, randomLength) {
+				randomSuffixString = randomSuffix
+			}
+			// padding is not stored with the suffix, so it is derived from
+			// the name to come out the same whenever the suffix is reused
+			rng = nil
+		}
+		// store the suffix as it appears in the name, e.g. lowercase crockford
+		randomSuffixString = applyCase(randomSuffixString, resourceType, config)
 	}
 
 	prefixes, err := convertFromTfList[string](ctx, config.Prefixes)
 	if err != nil {
 		diags.AddError("Error extracting prefixes", err.Error())
-		return "", diags
+		return "", "", diags
 	}
 
 	if !state.Prefixes.IsNull() {
 		prefixes, err = convertFromTfList[string](ctx, state.Prefixes)
 		if err != nil {
 			diags.AddError("Error extracting prefixes", err.Error())
-			return "", diags
+			return "", "", diags
 		}
 	}
 
 	suffixes, err := convertFromTfList[string](ctx, config.Suffixes)
 	if err != nil {
 		diags.AddError("Error extracting suffixes", err.Error())
-		return "", diags
+		return "", "", diags
 	}
 
 	if !state.Suffixes.IsNull() {
 		suffixes, err = convertFromTfList[string](ctx, state.Suffixes)
 		if err != nil {
 			diags.AddError("Error extracting suffixes", err.Error())
-			return "", diags
+			return "", "", diags
 		}
 	}
 
@@ -118,8 +192,8 @@ func GenerateName(ctx context.Context, state AznameNameModel, config AznameProvi
 	if location != "" {
 		region, err := regions.GetRegionByAnyName(location)
 		if err != nil {
-			diags.AddAttributeError(path.Root("location"), "unknown region", fmt.Sprintf("Unknown region: %s", location))
-			return "", diags
+			diags.AddAttributeError(path.Root("location"), "unknown region", err.Error())
+			return "", "", diags
 		}
 		regionShortName = region.ShortName
 	}
@@ -134,30 +208,12 @@ func GenerateName(ctx context.Context, state AznameNameModel, config AznameProvi
 		environment = config.Environment.ValueString()
 	}
 
-	replacer := strings.NewReplacer(
-		"{prefix}", strings.Join(prefixes, "~"),
-		"{parent_name}", state.ParentName.ValueString(),
-		"{resource_type}", resourceType.CafPrefix,
-		"{workload}", state.Name.ValueString(),
-		"{service}", state.Service.ValueString(),
-		"{environment}", environment,
-		"{location}", regionShortName,
-		"{suffix}", strings.Join(suffixes, "~"),
-		"{instance}", instanceString,
-		"{rand}", randomSuffixString,
-	)
-
-	result := config.Template.ValueString()
-
-	if !state.ParentName.IsNull() {
-		result = config.TemplateChild.ValueString()
+	template, templateDiags := selectTemplate(state, config, resourceType)
+	diags.Append(templateDiags...)
+	if diags.HasError() {
+		return "", "", diags
 	}
 
-	result = replacer.Replace(result)
-
-	result = regexp.MustCompile(`~{2,}`).ReplaceAllString(result, "~")
-	result = strings.Trim(result, "~")
-
 	separator := config.Separator.ValueString()
 	if !state.Separator.IsNull() {
 		separator = state.Separator.ValueString()
@@ -167,19 +223,144 @@ func GenerateName(ctx context.Context, state AznameNameModel, config AznameProvi
 		separator = ""
 	}
 
-	result = strings.ReplaceAll(result, "~", separator)
+	values := map[string]string{
+		"prefix":        strings.Join(prefixes, "~"),
+		"parent_name":   state.ParentName.ValueString(),
+		"resource_type": resourceType.CafPrefix,
+		"workload":      state.Name.ValueString(),
+		"service":       state.Service.ValueString(),
+		"environment":   environment,
+		"location":      regionShortName,
+		"suffix":        strings.Join(suffixes, "~"),
+		"instance":      instanceString,
+		"rand":          randomSuffixString,
+	}
+
+	// custom components add tokens on top of the built-in ones, with resource
+	// level values taking precedence over the provider defaults
+	for _, source := range []types.Map{config.Components, state.Components} {
+		var components map[string]string
+		diags.Append(source.ElementsAs(ctx, &components, false)...)
+		if diags.HasError() {
+			return "", "", diags
+		}
+
+		for token, value := range components {
+			if slices.Contains(nametemplate.BuiltinTokens, token) {
+				diags.AddAttributeError(
+					path.Root("components"),
+					"Invalid component",
+					fmt.Sprintf("Component %q conflicts with the built-in {%s} token.", token, token),
+				)
+				return "", "", diags
+			}
+			values[token] = value
+		}
+	}
+
+	parsedTemplate, err := nametemplate.Parse(template)
+	if err != nil {
+		diags.AddAttributeError(path.Root("template"), "Invalid template", err.Error())
+		return "", "", diags
+	}
+
+	for _, token := range parsedTemplate.Tokens() {
+		if _, ok := values[token]; !ok {
+			diags.AddAttributeError(
+				path.Root("components"),
+				"Unresolved template token",
+				fmt.Sprintf("Template %q references {%s}, which is neither a built-in token nor set in components.", template, token),
+			)
+			return "", "", diags
+		}
+	}
+
+	// render expands the template with the given token values and applies
+	// separator, case and cleanup rules. Truncation and padding re-render with
+	// adjusted values, so this is kept separate from length checks.
+	renderTemplate := func(parsedTemplate nametemplate.Template, values map[string]string) string {
+		result := parsedTemplate.Render(values)
 
-	// clean output
-	if config.CleanOutput.ValueBool() {
-		result = regexp.MustCompile(resourceType.RegEx).ReplaceAllString(result, "")
+		result = regexp.MustCompile(`~{2,}`).ReplaceAllString(result, "~")
+		result = strings.Trim(result, "~")
+
+		result = strings.ReplaceAll(result, "~", separator)
+
+		// normalize case before cleaning, otherwise the cleanup regex of
+		// lowercase-only resources would strip any uppercase characters
+		result = applyCase(result, resourceType, config)
+
+		// clean output
+		if config.CleanOutput.ValueBool() {
+			result = regexp.MustCompile(resourceType.RegEx).ReplaceAllString(result, "")
+		}
+
+		return result
+	}
+	render := func(values map[string]string) string {
+		return renderTemplate(parsedTemplate, values)
+	}
+
+	result := render(values)
+
+	// trim output to length, shortening the least significant segments first
+	if config.TrimOutput.ValueBool() && utf8.RuneCountInString(result) > resourceType.MaxLength {
+		priority, err := convertFromTfList[string](ctx, config.TruncatePriority)
+		if err != nil {
+			diags.AddError("Error extracting truncate_priority", err.Error())
+			return "", "", diags
+		}
+
+		strategy := config.TruncateStrategy.ValueString()
+		if !state.TruncateStrategy.IsNull() {
+			strategy = state.TruncateStrategy.ValueString()
+		}
+
+		untrimmed := result
+		if strategy == "hash" {
+			result = truncateNameWithHash(result, resourceType.MaxLength, separator, applyCase(hashName(result), resourceType, config))
+		} else {
+			unpadded := parsedTemplate.WithoutFilters("pad")
+			result = truncateName(values, priority, resourceType.MaxLength, separator, render, func(values map[string]string) string {
+				return renderTemplate(unpadded, values)
+			})
+		}
+		diags.AddWarning(
+			"Generated name truncated",
+			fmt.Sprintf("Generated name %q exceeds the maximum length of %d characters for %s and was truncated to %q.", untrimmed, resourceType.MaxLength, resourceType.ResourceTypeName, result),
+		)
 	}
 
-	// trim output to length
-	if config.TrimOutput.ValueBool() {
-		// runes are more reliable than bytes for trimming
-		runes := []rune(result)
-		trimLength := min(len(runes), resourceType.MaxLength)
-		result = string(runes[:trimLength])
+	// enforce the minimum length, padding the name if the provider allows it
+	if length := utf8.RuneCountInString(result); length < resourceType.MinLength {
+		strategy := config.PadStrategy.ValueString()
+		if strategy == "" || strategy == "none" {
+			diags.AddAttributeError(
+				path.Root("name"),
+				"Generated name too short",
+				fmt.Sprintf("Generated name %q is %d characters long, but %s requires at least %d characters. Use longer inputs or set pad_strategy on the provider.", result, length, resourceType.ResourceTypeName, resourceType.MinLength),
+			)
+			return result, randomSuffixString, diags
+		}
+
+		if rng == nil {
+			// derive the seed from the name so plan, apply and every later
+			// plan that reuses the suffix pad identically
+			h := fnv.New64a()
+			h.Write([]byte(result))
+			seed := h.Sum64()
+			rng = rand.New(rand.NewPCG(seed, seed))
+		}
+
+		padding := padSegment(strategy, resourceType.MinLength-length, rng)
+		paddedValues := maps.Clone(values)
+		paddedValues["rand"] += padding
+		padded := render(paddedValues)
+		if utf8.RuneCountInString(padded) < resourceType.MinLength {
+			// the template has no {rand} token, so append the padding instead
+			padded = result + padding
+		}
+		result = padded
 	}
 
 	// validate the output
@@ -188,5 +369,303 @@ func GenerateName(ctx context.Context, state AznameNameModel, config AznameProvi
 		diags.AddError("Generated name failed validation", fmt.Sprintf("Generated name %q failed validation against %q", result, resourceType.ValidationRegExp))
 	}
 
-	return result, diags
+	return result, randomSuffixString, diags
+}
+
+// usesRandom reports whether the name gets a random suffix. By default only
+// global-scope resource types do, which the random attribute can override.
+func usesRandom(state AznameNameModel, resourceType resources.ResourceStructure) bool {
+	switch state.Random.ValueString() {
+	case "always":
+		return true
+	case "never":
+		return false
+	}
+	return resourceType.Scope == "global"
+}
+
+// validateName checks a name against the naming rules of a resource type and
+// returns a human readable description of every rule it violates.
+func validateName(name string, resourceType resources.ResourceStructure) []string {
+	var violations []string
+
+	if length := utf8.RuneCountInString(name); length < resourceType.MinLength {
+		violations = append(violations, fmt.Sprintf("name is %d characters long, but %s requires at least %d characters", length, resourceType.ResourceTypeName, resourceType.MinLength))
+	} else if length > resourceType.MaxLength {
+		violations = append(violations, fmt.Sprintf("name is %d characters long, but %s allows at most %d characters", length, resourceType.ResourceTypeName, resourceType.MaxLength))
+	}
+
+	if resourceType.LowerCase && name != strings.ToLower(name) {
+		violations = append(violations, fmt.Sprintf("%s requires lowercase names", resourceType.ResourceTypeName))
+	}
+
+	if !resourceType.Dashes && strings.Contains(name, "-") {
+		violations = append(violations, fmt.Sprintf("%s does not allow dashes", resourceType.ResourceTypeName))
+	}
+
+	// resource types added through overrides have no character rules
+	if resourceType.RegEx != "" {
+		if invalid := regexp.MustCompile(resourceType.RegEx).FindAllString(name, -1); len(invalid) > 0 {
+			slices.Sort(invalid)
+			violations = append(violations, fmt.Sprintf("name contains characters that are not allowed for %s: %q", resourceType.ResourceTypeName, strings.Join(slices.Compact(invalid), "")))
+		}
+	}
+
+	if !regexp.MustCompile(resourceType.ValidationRegExp).MatchString(name) {
+		violations = append(violations, fmt.Sprintf("name does not match the pattern %q for %s", resourceType.ValidationRegExp, resourceType.ResourceTypeName))
+	}
+
+	return violations
+}
+
+// checkCustomName validates custom_name against the naming rules of the
+// resource type, unless skip_validation is set. Values that are not yet known
+// are checked once they are.
+func checkCustomName(state AznameNameModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if state.CustomName.IsNull() || state.CustomName.IsUnknown() || state.ResourceType.IsUnknown() ||
+		state.SkipValidation.IsUnknown() || state.SkipValidation.ValueBool() {
+		return diags
+	}
+
+	resourceType, err := resources.GetResourceDefinition(state.ResourceType.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("resource_type"), "unknown resource type", err.Error())
+		return diags
+	}
+
+	if violations := validateName(state.CustomName.ValueString(), resourceType); len(violations) > 0 {
+		diags.AddAttributeError(
+			path.Root("custom_name"),
+			"Invalid custom name",
+			fmt.Sprintf("Custom name %q is not valid for %s: %s. Set skip_validation to use it anyway.", state.CustomName.ValueString(), resourceType.ResourceTypeName, strings.Join(violations, "; ")),
+		)
+	}
+
+	return diags
+}
+
+// randomCharset returns the random_charset of the name, falling back to the
+// provider setting.
+func randomCharset(state AznameNameModel, config AznameProviderModel) string {
+	if !state.RandomCharset.IsNull() {
+		return state.RandomCharset.ValueString()
+	}
+	return config.RandomCharset.ValueString()
+}
+
+// selectTemplate returns the template to generate a name with. An inline
+// template on the resource wins, followed by a named template from the
+// provider's templates map, a template override for the resource type, and
+// finally the provider's child or default template.
+func selectTemplate(state AznameNameModel, config AznameProviderModel, resourceType resources.ResourceStructure) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !state.Template.IsNull() {
+		return state.Template.ValueString(), diags
+	}
+
+	if !state.TemplateName.IsNull() {
+		templates := config.Templates.Elements()
+		template, ok := templates[state.TemplateName.ValueString()].(types.String)
+		if !ok {
+			names := slices.Sorted(maps.Keys(templates))
+			diags.AddAttributeError(
+				path.Root("template_name"),
+				"unknown template",
+				fmt.Sprintf("Template %q is not defined in the provider templates. Available templates: %s", state.TemplateName.ValueString(), strings.Join(names, ", ")),
+			)
+			return "", diags
+		}
+		return template.ValueString(), diags
+	}
+
+	if template, ok := resources.GetResourceTemplate(resourceType.ResourceTypeName); ok {
+		return template, diags
+	}
+
+	if !state.ParentName.IsNull() {
+		return config.TemplateChild.ValueString(), diags
+	}
+
+	return config.Template.ValueString(), diags
+}
+
+// applyCase normalizes the case of a name. Resource types that require
+// lowercase names always win, otherwise the provider level case mode applies.
+func applyCase(name string, resourceType resources.ResourceStructure, config AznameProviderModel) string {
+	if resourceType.LowerCase {
+		return strings.ToLower(name)
+	}
+
+	switch config.Case.ValueString() {
+	case "lower":
+		return strings.ToLower(name)
+	case "upper":
+		return strings.ToUpper(name)
+	}
+
+	return name
+}
+
+// truncateName shortens the values of the priority tokens, in order, until the
+// rendered name fits within maxLength. Every other token is left intact so the
+// random suffix, instance and location survive. pad filters refill shortened
+// values, so if that is not enough the name is rendered without them. As a
+// last resort the name is cut at maxLength, leaving the random suffix in
+// place.
+func truncateName(values map[string]string, priority []string, maxLength int, separator string, render, renderUnpadded func(map[string]string) string) string {
+	var shortened map[string]string
+	for _, render := range []func(map[string]string) string{render, renderUnpadded} {
+		shortened = maps.Clone(values)
+		result := render(shortened)
+		for _, token := range priority {
+			for {
+				overflow := utf8.RuneCountInString(result) - maxLength
+				runes := []rune(shortened[token])
+				if overflow <= 0 || len(runes) <= 1 {
+					break
+				}
+				shortened[token] = string(runes[:max(1, len(runes)-overflow)])
+				result = render(shortened)
+			}
+		}
+		if utf8.RuneCountInString(result) <= maxLength {
+			return result
+		}
+	}
+
+	return cutName(shortened, maxLength, separator, renderUnpadded)
+}
+
+// cutName cuts the rendered name at maxLength, skipping over the random
+// suffix so that it keeps its place in the name. Whatever follows the cut is
+// dropped, which can include the instance and location. Separators are not
+// left at the cut.
+func cutName(values map[string]string, maxLength int, separator string, render func(map[string]string) string) string {
+	withoutRand := maps.Clone(values)
+	withoutRand["rand"] = ""
+	full := render(values)
+	bare := render(withoutRand)
+
+	// split the name into the text before the suffix, the suffix with its
+	// separators and the text after it
+	fullRunes, bareRunes := []rune(full), []rune(bare)
+	prefixLength := 0
+	for prefixLength < len(bareRunes) && prefixLength < len(fullRunes) && bareRunes[prefixLength] == fullRunes[prefixLength] {
+		prefixLength++
+	}
+	head, suffix, tail := string(bareRunes[:prefixLength]), string(fullRunes[prefixLength:]), string(bareRunes[prefixLength:])
+	if strings.HasSuffix(suffix, tail) {
+		suffix = strings.TrimSuffix(suffix, tail)
+	} else {
+		// the suffix changed the rest of the name, so it goes at the end
+		head, suffix, tail = bare, values["rand"], ""
+	}
+	if separator != "" {
+		trimmed := strings.TrimRight(head, separator)
+		head, suffix = trimmed, head[len(trimmed):]+suffix
+	}
+
+	trim := func(name string) string {
+		if separator == "" {
+			return name
+		}
+		return strings.TrimRight(name, separator)
+	}
+
+	headRunes, tailRunes := []rune(head), []rune(tail)
+	keep := max(0, maxLength-utf8.RuneCountInString(suffix))
+	if keep <= len(headRunes) {
+		head = trim(string(headRunes[:keep]))
+		if head == "" && separator != "" {
+			suffix = strings.TrimLeft(suffix, separator)
+		}
+		return trim(head + suffix)
+	}
+	return trim(head + suffix + string(tailRunes[:min(len(tailRunes), keep-len(headRunes))]))
+}
+
+// truncateNameWithHash cuts the name so that it fits within maxLength once the
+// hash is appended. The hash is derived from the full name, so distinct names
+// remain distinct after truncation.
+func truncateNameWithHash(name string, maxLength int, separator string, hash string) string {
+	hash = string([]rune(hash)[:min(utf8.RuneCountInString(hash), maxLength/2)])
+	keep := max(0, maxLength-utf8.RuneCountInString(hash)-utf8.RuneCountInString(separator))
+
+	runes := []rune(name)
+	trimmed := string(runes[:min(len(runes), keep)])
+	if separator != "" {
+		trimmed = strings.TrimRight(trimmed, separator)
+	}
+
+	if trimmed == "" {
+		return hash
+	}
+	return trimmed + separator + hash
+}
+
+// hashName returns a short deterministic hash of a name.
+func hashName(name string) string {
+	sum := sha256.Sum256([]byte(name))
+	return hex.EncodeToString(sum[:])[:hashSuffixLength]
+}
+
+// uniqueSeed derives a PCG seed from the unique_from values, similar to ARM's
+// uniqueString(). Azure IDs are case-insensitive, so the values are lowercased
+// before hashing.
+func uniqueSeed(values []string) (uint64, uint64) {
+	sum := sha256.Sum256([]byte(strings.ToLower(strings.Join(values, "\x00"))))
+	return binary.BigEndian.Uint64(sum[:8]), binary.BigEndian.Uint64(sum[8:16])
+}
+
+// randomString draws length characters from the alphabet of charset. The
+// numeric charset is drawn as a single number so that seeded names stay the
+// same as before random_charset was introduced.
+func randomString(charset string, length int, rng *rand.Rand) string {
+	alphabet, ok := randomCharsets[charset]
+	if !ok {
+		return fmt.Sprintf("%0*d", length, rng.IntN(int(math.Pow10(length)-1)))
+	}
+
+	var sb strings.Builder
+	for range length {
+		sb.WriteByte(alphabet[rng.IntN(len(alphabet))])
+	}
+	return sb.String()
+}
+
+// matchesCharset reports whether suffix is a random suffix of the given
+// length drawn from charset. Suffixes take the case of the name, so case is
+// ignored.
+func matchesCharset(suffix string, charset string, length int) bool {
+	if len(suffix) != length {
+		return false
+	}
+
+	alphabet, ok := randomCharsets[charset]
+	if !ok {
+		alphabet = "0123456789"
+	}
+	for _, c := range suffix {
+		if !strings.ContainsRune(alphabet, unicode.ToLower(c)) && !strings.ContainsRune(alphabet, unicode.ToUpper(c)) {
+			return false
+		}
+	}
+	return true
+}
+
+// padSegment returns length characters of padding for the given strategy.
+// The random strategy draws digits from rng, filler repeats a zero.
+func padSegment(strategy string, length int, rng *rand.Rand) string {
+	if strategy == "filler" {
+		return strings.Repeat("0", length)
+	}
+
+	var sb strings.Builder
+	for range length {
+		sb.WriteString(strconv.Itoa(rng.IntN(10)))
+	}
+	return sb.String()
 }
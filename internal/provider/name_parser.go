@@ -0,0 +1,423 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"terraform-provider-azname/internal/nametemplate"
+	"terraform-provider-azname/internal/regions"
+	"terraform-provider-azname/internal/resources"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// maxParseTokens limits the number of optional tokens parseName matches, as
+// every combination of present and empty tokens is tried.
+const maxParseTokens = 12
+
+// specificTokens have values of a known shape. Matches that resolve more of
+// them are preferred over matches that put the same text in free-form tokens.
+var specificTokens = []string{"location", "instance", "rand"}
+
+// repeatedSeparators matches the runs of separator placeholders left by empty
+// tokens.
+var repeatedSeparators = sync.OnceValue(func() *regexp.Regexp {
+	return regexp.MustCompile(`~{2,}`)
+})
+
+// locationPattern matches the short name of any region, preferring the
+// longest, e.g. wus2 over wus.
+var locationPattern = sync.OnceValue(func() string {
+	shortNames := regions.ShortNames()
+	slices.SortFunc(shortNames, func(a, b string) int {
+		return cmp.Compare(len(b), len(a))
+	})
+	for i := range shortNames {
+		shortNames[i] = regexp.QuoteMeta(shortNames[i])
+	}
+	return strings.Join(shortNames, "|")
+})
+
+// newNameModel returns a name model for resourceType with every other input
+// unset.
+func newNameModel(resourceType string) AznameNameModel {
+	return AznameNameModel{
+		ID:               types.StringNull(),
+		Result:           types.StringNull(),
+		Name:             types.StringNull(),
+		Environment:      types.StringNull(),
+		CustomName:       types.StringNull(),
+		ResourceType:     types.StringValue(resourceType),
+		Prefixes:         types.ListNull(types.StringType),
+		Suffixes:         types.ListNull(types.StringType),
+		Separator:        types.StringNull(),
+		RandomSeed:       types.Int64Null(),
+		Location:         types.StringNull(),
+		Instance:         types.Int64Null(),
+		Service:          types.StringNull(),
+		ParentName:       types.StringNull(),
+		TruncateStrategy: types.StringNull(),
+		TemplateName:     types.StringNull(),
+		Template:         types.StringNull(),
+		Components:       types.MapNull(types.StringType),
+		RandomCharset:    types.StringNull(),
+		UniqueFrom:       types.ListNull(types.StringType),
+		Random:           types.StringNull(),
+		SkipValidation:   types.BoolNull(),
+		Context:          types.ObjectNull(nameContextAttributeTypes),
+	}
+}
+
+// parsedName is the result of matching a name against its template.
+type parsedName struct {
+	// values holds the matched value of each template token, empty for
+	// tokens that are not present.
+	values map[string]string
+	// model holds the inputs that generate the name again.
+	model AznameNameModel
+}
+
+// parseName matches an existing name against the template that state would
+// generate it with, and returns the inputs that reproduce it. Every
+// combination of present and empty tokens is tried; among the combinations
+// that generate exactly the same name, the one resolving the most
+// specifically shaped tokens wins, followed by the one whose present tokens
+// come earliest in the template. ok is false when nothing reproduces the name.
+func parseName(ctx context.Context, name string, state AznameNameModel, config AznameProviderModel) (parsed parsedName, ok bool, diags diag.Diagnostics) {
+	resourceType, err := resources.GetResourceDefinition(state.ResourceType.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("resource_type"), "unknown resource type", err.Error())
+		return parsedName{}, false, diags
+	}
+
+	// child resources are generated with template_child, which is only
+	// selected when parent_name is set
+	variants := []AznameNameModel{state}
+	if state.ParentName.IsNull() {
+		child := state
+		child.ParentName = types.StringValue("")
+		variants = append(variants, child)
+	}
+
+	var best []int
+	for _, variant := range variants {
+		template, templateDiags := selectTemplate(variant, config, resourceType)
+		if templateDiags.HasError() {
+			diags.Append(templateDiags...)
+			return parsedName{}, false, diags
+		}
+
+		parsedTemplate, err := nametemplate.Parse(template)
+		if err != nil {
+			diags.AddAttributeError(path.Root("template"), "Invalid template", err.Error())
+			return parsedName{}, false, diags
+		}
+
+		for _, candidate := range matchTemplate(name, parsedTemplate, variant, config, resourceType) {
+			model, err := modelFromValues(ctx, candidate, variant, config, resourceType)
+			if err != nil {
+				continue
+			}
+
+			result, _, generateDiags := generateName(ctx, model, config, candidate["rand"])
+			if generateDiags.HasError() || result != name {
+				continue
+			}
+
+			score := parseScore(candidate, parsedTemplate, config, resourceType)
+			if best == nil || slices.Compare(score, best) > 0 {
+				best = score
+				parsed = parsedName{values: candidate, model: model}
+			}
+		}
+
+		if best != nil {
+			return parsed, true, diags
+		}
+	}
+
+	return parsedName{}, false, diags
+}
+
+// matchTemplate returns the token values of every way name matches the
+// template, with empty values for the tokens that are not present. Tokens are
+// rendered as placeholders and the result is turned into a regular
+// expression, so empty tokens, separators and optional groups collapse exactly
+// as they do when generating a name.
+func matchTemplate(name string, template nametemplate.Template, state AznameNameModel, config AznameProviderModel, resourceType resources.ResourceStructure) []map[string]string {
+	separator := config.Separator.ValueString()
+	if !state.Separator.IsNull() {
+		separator = state.Separator.ValueString()
+	}
+	if !resourceType.Dashes {
+		separator = ""
+	}
+
+	// filters change token values in ways that cannot be reversed, so tokens
+	// are matched on their unfiltered shape
+	unfiltered := template.WithoutFilters(nametemplate.FilterNames...)
+
+	var required, optional []string
+	for _, token := range template.Tokens() {
+		if token == "workload" || (token == "resource_type" && resourceType.CafPrefix != "") {
+			required = append(required, token)
+			continue
+		}
+		optional = append(optional, token)
+	}
+	if len(optional) > maxParseTokens {
+		return nil
+	}
+
+	var candidates []map[string]string
+	for mask := 0; mask < 1<<len(optional); mask++ {
+		present := slices.Clone(required)
+		for i, token := range optional {
+			if mask&(1<<i) != 0 {
+				present = append(present, token)
+			}
+		}
+
+		// render the template with a unique placeholder for each present token
+		values := make(map[string]string, len(present))
+		for _, token := range template.Tokens() {
+			values[token] = ""
+		}
+		for i, token := range present {
+			values[token] = fmt.Sprintf("\x00%d\x00", i)
+		}
+
+		rendered := unfiltered.Render(values)
+		rendered = repeatedSeparators().ReplaceAllString(rendered, "~")
+		rendered = strings.Trim(rendered, "~")
+
+		// free-form tokens only match one way per combination, so the
+		// provider's environment is also tried as a literal environment
+		environments := []string{""}
+		if environment := config.Environment.ValueString(); environment != "" && slices.Contains(present, "environment") {
+			environments = append(environments, regexp.QuoteMeta(environment))
+		}
+
+		for _, environmentPattern := range environments {
+			var pattern strings.Builder
+			var groups []string
+			pattern.WriteString("(?i)^")
+			for i, part := range strings.Split(rendered, "\x00") {
+				if i%2 == 0 {
+					pattern.WriteString(regexp.QuoteMeta(strings.ReplaceAll(part, "~", separator)))
+					continue
+				}
+				index, _ := strconv.Atoi(part)
+				token := present[index]
+				groups = append(groups, token)
+
+				tokenRegex := tokenPattern(token, separator, config, state, resourceType)
+				if token == "environment" && environmentPattern != "" {
+					tokenRegex = environmentPattern
+				}
+				pattern.WriteString("(" + tokenRegex + ")")
+			}
+			pattern.WriteString("$")
+
+			match := regexp.MustCompile(pattern.String()).FindStringSubmatch(name)
+			if match == nil {
+				continue
+			}
+
+			candidate := make(map[string]string, len(values))
+			for token := range values {
+				candidate[token] = ""
+			}
+			// a token used more than once keeps its first value, the name is
+			// verified by generating it again anyway
+			for i, token := range groups {
+				if candidate[token] == "" {
+					candidate[token] = match[i+1]
+				}
+			}
+			candidates = append(candidates, candidate)
+		}
+	}
+
+	return candidates
+}
+
+// tokenPattern returns the regular expression matching the value of token.
+func tokenPattern(token string, separator string, config AznameProviderModel, state AznameNameModel, resourceType resources.ResourceStructure) string {
+	switch token {
+	case "resource_type":
+		return regexp.QuoteMeta(resourceType.CafPrefix)
+	case "location":
+		return locationPattern()
+	case "instance":
+		return fmt.Sprintf(`\d{%d,}`, config.InstanceLength.ValueInt64())
+	case "rand":
+		alphabet, ok := randomCharsets[randomCharset(state, config)]
+		if !ok {
+			alphabet = "0123456789"
+		}
+		return fmt.Sprintf("[%s]{%d}", regexp.QuoteMeta(alphabet), config.RandomLength.ValueInt64())
+	case "parent_name", "prefix", "suffix":
+		return ".+?"
+	}
+
+	// other free-form tokens rarely contain the separator
+	if utf8.RuneCountInString(separator) == 1 {
+		return "[^" + regexp.QuoteMeta(separator) + "]+?"
+	}
+	return ".+?"
+}
+
+// parseScore ranks a verified candidate, preferring in order: a random suffix
+// only where the resource type has one by default, more specifically shaped
+// tokens, the provider's environment, fewer free-form tokens and finally
+// tokens that come earlier in the template.
+func parseScore(candidate map[string]string, template nametemplate.Template, config AznameProviderModel, resourceType resources.ResourceStructure) []int {
+	var defaultRandom, specific, defaultEnvironment, freeForm int
+
+	if (candidate["rand"] != "") == (resourceType.Scope == "global") {
+		defaultRandom = 1
+	}
+
+	for token, value := range candidate {
+		switch {
+		case value == "" || token == "resource_type":
+		case slices.Contains(specificTokens, token):
+			specific++
+		default:
+			freeForm--
+		}
+	}
+
+	if environment := config.Environment.ValueString(); environment != "" && strings.EqualFold(candidate["environment"], environment) {
+		defaultEnvironment = 1
+	}
+
+	score := []int{defaultRandom, specific, defaultEnvironment, freeForm}
+	for _, token := range template.Tokens() {
+		present := 0
+		if candidate[token] != "" {
+			present = 1
+		}
+		score = append(score, present)
+	}
+
+	return score
+}
+
+// modelFromValues converts matched token values to the name inputs that
+// generate them. Values equal to the provider defaults are left unset, so
+// imported names match configurations that rely on those defaults.
+func modelFromValues(ctx context.Context, values map[string]string, state AznameNameModel, config AznameProviderModel, resourceType resources.ResourceStructure) (AznameNameModel, error) {
+	model := state
+
+	if workload := values["workload"]; workload != "" {
+		model.Name = types.StringValue(workload)
+	}
+
+	if service := values["service"]; service != "" {
+		model.Service = types.StringValue(service)
+	}
+
+	if parentName := values["parent_name"]; parentName != "" {
+		model.ParentName = types.StringValue(parentName)
+	}
+
+	if environment := values["environment"]; environment != "" && environment != config.Environment.ValueString() {
+		model.Environment = types.StringValue(environment)
+	}
+
+	if shortName := values["location"]; shortName != "" {
+		region, err := regions.GetRegionByShortName(shortName)
+		if err != nil {
+			return model, err
+		}
+		if defaultRegion, err := regions.GetRegionByAnyName(config.Location.ValueString()); err != nil || defaultRegion.CliName != region.CliName {
+			model.Location = types.StringValue(region.CliName)
+		}
+	}
+
+	if instance := values["instance"]; instance != "" {
+		number, err := strconv.ParseInt(instance, 10, 64)
+		if err != nil {
+			return model, err
+		}
+		model.Instance = types.Int64Value(number)
+	}
+
+	separator := config.Separator.ValueString()
+	if !state.Separator.IsNull() {
+		separator = state.Separator.ValueString()
+	}
+
+	for _, affix := range []struct {
+		token    string
+		defaults types.List
+		target   *types.List
+	}{
+		{"prefix", config.Prefixes, &model.Prefixes},
+		{"suffix", config.Suffixes, &model.Suffixes},
+	} {
+		value, ok := values[affix.token]
+		if !ok {
+			continue
+		}
+
+		defaults, err := convertFromTfList[string](ctx, affix.defaults)
+		if err != nil {
+			return model, err
+		}
+		if value == strings.Join(defaults, separator) {
+			continue
+		}
+
+		var elements []attr.Value
+		if value != "" {
+			parts := []string{value}
+			if separator != "" && resourceType.Dashes {
+				parts = strings.Split(value, separator)
+			}
+			for _, part := range parts {
+				elements = append(elements, types.StringValue(part))
+			}
+		}
+		*affix.target = types.ListValueMust(types.StringType, elements)
+	}
+
+	var defaultComponents map[string]string
+	if diags := config.Components.ElementsAs(ctx, &defaultComponents, false); diags.HasError() {
+		return model, fmt.Errorf("reading provider components")
+	}
+	components := map[string]attr.Value{}
+	for token, value := range values {
+		if slices.Contains(nametemplate.BuiltinTokens, token) || defaultComponents[token] == value {
+			continue
+		}
+		components[token] = types.StringValue(value)
+	}
+	if len(components) > 0 {
+		model.Components = types.MapValueMust(types.StringType, components)
+	}
+
+	// random is only set when the default of the resource type would not
+	// produce the name
+	hasRandom := values["rand"] != ""
+	switch {
+	case hasRandom && resourceType.Scope != "global":
+		model.Random = types.StringValue("always")
+	case !hasRandom && resourceType.Scope == "global":
+		model.Random = types.StringValue("never")
+	}
+
+	return model, nil
+}
@@ -6,8 +6,11 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -32,25 +35,39 @@ type AznameResource struct {
 
 // These are shared between the resource and data source implementations.
 type AznameNameModel struct {
-	ID           types.String `tfsdk:"id"`
-	Result       types.String `tfsdk:"result"`
-	Name         types.String `tfsdk:"name"`
-	Environment  types.String `tfsdk:"environment"`
-	CustomName   types.String `tfsdk:"custom_name"`
-	ResourceType types.String `tfsdk:"resource_type"`
-	Prefixes     types.List   `tfsdk:"prefixes"`
-	Suffixes     types.List   `tfsdk:"suffixes"`
-	Separator    types.String `tfsdk:"separator"`
-	RandomSeed   types.Int64  `tfsdk:"random_seed"`
-	Location     types.String `tfsdk:"location"`
-	Instance     types.Int64  `tfsdk:"instance"`
-	Service      types.String `tfsdk:"service"`
-	ParentName   types.String `tfsdk:"parent_name"`
+	ID               types.String `tfsdk:"id"`
+	Result           types.String `tfsdk:"result"`
+	Name             types.String `tfsdk:"name"`
+	Environment      types.String `tfsdk:"environment"`
+	CustomName       types.String `tfsdk:"custom_name"`
+	ResourceType     types.String `tfsdk:"resource_type"`
+	Prefixes         types.List   `tfsdk:"prefixes"`
+	Suffixes         types.List   `tfsdk:"suffixes"`
+	Separator        types.String `tfsdk:"separator"`
+	RandomSeed       types.Int64  `tfsdk:"random_seed"`
+	Location         types.String `tfsdk:"location"`
+	Instance         types.Int64  `tfsdk:"instance"`
+	Service          types.String `tfsdk:"service"`
+	ParentName       types.String `tfsdk:"parent_name"`
+	TruncateStrategy types.String `tfsdk:"truncate_strategy"`
+	TemplateName     types.String `tfsdk:"template_name"`
+	Template         types.String `tfsdk:"template"`
+	Components       types.Map    `tfsdk:"components"`
+	RandomCharset    types.String `tfsdk:"random_charset"`
+	UniqueFrom       types.List   `tfsdk:"unique_from"`
+	Random           types.String `tfsdk:"random"`
+	SkipValidation   types.Bool   `tfsdk:"skip_validation"`
+	Context          types.Object `tfsdk:"context"`
 }
 
 type AznameResourceModel struct {
 	AznameNameModel
-	Triggers types.Map `tfsdk:"triggers"`
+	Triggers           types.Map    `tfsdk:"triggers"`
+	RandomSuffix       types.String `tfsdk:"random_suffix"`
+	RegenerateRandom   types.String `tfsdk:"regenerate_random"`
+	RegenerateOnChange types.Bool   `tfsdk:"regenerate_on_change"`
+	DesiredResult      types.String `tfsdk:"desired_result"`
+	Drifted            types.Bool   `tfsdk:"drifted"`
 }
 
 func (r *AznameResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -73,9 +90,18 @@ func (r *AznameResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				MarkdownDescription: "The generated resource name following the configured template pattern.",
 			},
 			"name": schema.StringAttribute{
-				Required:            true,
-				Description:         "The workload or application name to use in the resource name.",
-				MarkdownDescription: "The workload or application name to use in the resource name.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.AtLeastOneOf(path.MatchRoot("context")),
+				},
+				Description:         "The workload or application name to use in the resource name. Required unless context provides it.",
+				MarkdownDescription: "The workload or application name to use in the resource name. Required unless `context` provides it.",
+			},
+			"context": schema.ObjectAttribute{
+				Optional:            true,
+				AttributeTypes:      nameContextAttributeTypes,
+				Description:         "Context from an azname_context data source providing the shared workload inputs.",
+				MarkdownDescription: "The `context` of an `azname_context` data source, providing `name`, `environment`, `location`, `prefixes`, `suffixes` and `components` for inputs that are not set on this name. Components of the context are merged under `components`.",
 			},
 			"environment": schema.StringAttribute{
 				Optional:            true,
@@ -85,12 +111,17 @@ func (r *AznameResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			"custom_name": schema.StringAttribute{
 				Optional:            true,
 				Description:         "Override the generated name with a custom value. Useful for legacy or imported resources.",
-				MarkdownDescription: "Override the generated name with a custom value. Useful for legacy or imported resources.",
+				MarkdownDescription: "Override the generated name with a custom value. Useful for legacy or imported resources. The name is checked against the length, character and pattern rules of the resource type unless `skip_validation` is set.",
+			},
+			"skip_validation": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Skip the resource type naming rules when checking custom_name.",
+				MarkdownDescription: "Skip the resource type naming rules when checking `custom_name`. Use this for legacy names that predate the naming convention and break its rules. Default: false",
 			},
 			"resource_type": schema.StringAttribute{
 				Required:            true,
-				Description:         "The Azure resource type abbreviation (e.g., rg for resource group, kv for key vault).",
-				MarkdownDescription: "The Azure resource type abbreviation (e.g., rg for resource group, kv for key vault).",
+				Description:         "The Azure resource type, as an azurerm type name (e.g., azurerm_key_vault), CAF slug (e.g., kv) or resource provider namespace (e.g., Microsoft.KeyVault/vaults).",
+				MarkdownDescription: "The Azure resource type, as an azurerm type name (e.g., `azurerm_key_vault`), CAF slug (e.g., `kv`) or Azure resource provider namespace (e.g., `Microsoft.KeyVault/vaults`). Slugs and namespaces shared by several resource types are rejected with a list of candidates; use the azurerm type name in that case.",
 			},
 			"prefixes": schema.ListAttribute{
 				Optional:            true,
@@ -137,6 +168,59 @@ func (r *AznameResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Description:         "Name of the parent resource for child resources.",
 				MarkdownDescription: "Name of the parent resource. Required when generating names for child resources.",
 			},
+			"truncate_strategy": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("segment", "hash"),
+				},
+				Description:         "How to shorten the name if it exceeds the maximum length. Defaults to provider's truncate_strategy setting.",
+				MarkdownDescription: "How to shorten the name if it exceeds the resource type maximum length: `segment` or `hash`. Defaults to provider's `truncate_strategy` setting.",
+			},
+			"template_name": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Name of a template from the provider's templates map to generate the name with.",
+				MarkdownDescription: "Name of a template from the provider's `templates` map to generate the name with. Defaults to the provider's `template`, or `template_child` when `parent_name` is set.",
+			},
+			"template": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("template_name")),
+				},
+				Description:         "Inline template to generate the name with, instead of the provider templates.",
+				MarkdownDescription: "Inline template to generate the name with, instead of the provider templates. Uses ~ as a placeholder for the separator character. Conflicts with `template_name`.",
+			},
+			"random": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("auto", "always", "never"),
+				},
+				Description:         "Whether to generate the random suffix: auto, always or never. Default: auto",
+				MarkdownDescription: "Whether to generate the `{rand}` suffix: `auto` (default) only for global-scope resource types, `always` for any resource type, such as blue/green deployments in the same resource group, or `never`.",
+			},
+			"unique_from": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ConflictsWith(path.MatchRoot("random_seed")),
+				},
+				Description:         "Values to derive a stable random suffix from, such as a subscription or resource group ID.",
+				MarkdownDescription: "Values to derive a stable random suffix from, such as a subscription, resource group or tenant ID, similar to ARM's `uniqueString()`. The same values always produce the same suffix, so global-scope names are known at plan time. Values are compared case-insensitively. Conflicts with `random_seed`.",
+			},
+			"random_charset": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(randomCharsetNames...),
+				},
+				Description:         "Characters to draw the random suffix from. Defaults to provider's random_charset setting.",
+				MarkdownDescription: "Characters to draw the `{rand}` suffix from: `numeric`, `hex`, `alnum` or `crockford`. Defaults to provider's `random_charset` setting.",
+			},
+			"components": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				Description:         "Map of values for custom template tokens, merged over the provider's components.",
+				MarkdownDescription: "Map of values for custom template tokens, merged over the provider's `components`. A key of `team` provides the value for a `{team}` token. Templates referencing a token without a value are rejected.",
+			},
 			"triggers": schema.MapAttribute{
 				Optional:    true,
 				ElementType: types.StringType,
@@ -146,6 +230,31 @@ func (r *AznameResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Description:         "Map of values that should trigger a new name to be generated when changed.",
 				MarkdownDescription: "Map of values that should trigger a new name to be generated when changed. Common triggers include version numbers or Git commit hashes.",
 			},
+			"random_suffix": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The random suffix used in the generated name, reused when the name is regenerated.",
+				MarkdownDescription: "The `{rand}` suffix used in the generated name. It is stored separately from `result` and reused whenever the name is regenerated, until `triggers` or `regenerate_random` change or `random_length` or `random_charset` no longer match it. Suffixes seeded by `random_seed` or `unique_from` are always derived from the seed.",
+			},
+			"regenerate_random": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Arbitrary value that generates a new random suffix in place when changed.",
+				MarkdownDescription: "Arbitrary value that generates a new name with a new random suffix, in place, when changed. Seeded suffixes (`random_seed` or `unique_from`) are deterministic and do not change.",
+			},
+			"desired_result": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The name the current configuration generates, which may differ from the stored result.",
+				MarkdownDescription: "The name the current configuration generates, which differs from the stored `result` when the inputs or naming conventions changed after the name was created. Null when it cannot be determined, such as for unseeded global names created before `random_suffix` was stored.",
+			},
+			"drifted": schema.BoolAttribute{
+				Computed:            true,
+				Description:         "Whether the stored result differs from desired_result.",
+				MarkdownDescription: "Whether the stored `result` differs from `desired_result`. Useful in `check` blocks to find naming convention drift without replacing names.",
+			},
+			"regenerate_on_change": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Whether to update the name in place when the inputs no longer match it. Default: false",
+				MarkdownDescription: "Whether to update `result` in place when the inputs, such as `name`, `environment` or `location`, no longer generate the stored name. The stored `random_suffix` is kept. By default the stored name is preserved and a warning shows the name the current inputs would generate.",
+			},
 		},
 	}
 }
@@ -171,7 +280,6 @@ func (r *AznameResource) Configure(ctx context.Context, req resource.ConfigureRe
 
 func (r *AznameResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var state AznameResourceModel
-	var result string
 
 	config := *r.config
 
@@ -182,14 +290,22 @@ func (r *AznameResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	// If a custom_name is provided, use that as the result
 	if !state.CustomName.IsNull() {
+		resp.Diagnostics.Append(checkCustomName(state.AznameNameModel)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
 		state.Result = state.CustomName
 		state.ID = state.CustomName
+		state.RandomSuffix = types.StringNull()
+		setDesiredResult(&state, state.Result.ValueString(), true)
 		resp.State.Set(ctx, state)
 
 		return
 	}
 
-	result, diags := GenerateName(ctx, state.AznameNameModel, config)
+	// The plan holds the suffix when it is known, otherwise a new one is drawn
+	result, randomSuffix, diags := generateName(ctx, state.AznameNameModel, config, state.RandomSuffix.ValueString())
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -197,6 +313,8 @@ func (r *AznameResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	state.Result = types.StringValue(result)
 	state.ID = types.StringValue(result)
+	state.RandomSuffix = suffixValue(randomSuffix)
+	setDesiredResult(&state, result, true)
 	resp.State.Set(ctx, state)
 }
 
@@ -212,6 +330,70 @@ func (r *AznameResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	// The plan could not determine the new name, because regenerate_random
+	// changed for an unseeded name or the inputs were only known during apply
+	if state.Result.IsUnknown() {
+		if !state.CustomName.IsNull() {
+			resp.Diagnostics.Append(checkCustomName(state.AznameNameModel)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			state.Result = state.CustomName
+			state.ID = state.CustomName
+			state.RandomSuffix = types.StringNull()
+		} else {
+			var prior AznameResourceModel
+			resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			needsRandom, diags := NeedsRandomGeneration(ctx, state.AznameNameModel)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			// The planned suffix is unknown along with the name, so the
+			// stored one is reused unless a new one was requested
+			if state.RandomSuffix.IsUnknown() && state.RegenerateRandom.Equal(prior.RegenerateRandom) {
+				state.RandomSuffix = r.storedRandomSuffix(ctx, prior)
+			}
+
+			// Without a stored suffix, regenerating the name on changed inputs
+			// would silently draw a new one, so the stored name is kept, as
+			// it is when the inputs are known during plan
+			if needsRandom && state.RandomSuffix.IsNull() && state.RegenerateRandom.Equal(prior.RegenerateRandom) {
+				resp.Diagnostics.AddAttributeWarning(
+					path.Root("result"),
+					"Name not regenerated",
+					fmt.Sprintf("The stored name %q has no stored random suffix to reuse, so it is kept. Change regenerate_random or triggers to generate a new name.", prior.Result.ValueString()),
+				)
+				state.Result = prior.Result
+				state.ID = prior.ID
+				setDesiredResult(&state, "", false)
+			} else {
+				result, randomSuffix, diags := generateName(ctx, state.AznameNameModel, *r.config, state.RandomSuffix.ValueString())
+				resp.Diagnostics.Append(diags...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				state.Result = types.StringValue(result)
+				state.ID = types.StringValue(result)
+				state.RandomSuffix = suffixValue(randomSuffix)
+			}
+		}
+	}
+
+	// The inputs were only known during apply, so compare the names now. The
+	// comparison is informational and never fails the apply.
+	if state.DesiredResult.IsUnknown() || state.Drifted.IsUnknown() {
+		desired, _, ok, _ := r.desiredResult(ctx, state)
+		setDesiredResult(&state, desired, ok)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -241,23 +423,65 @@ func (r *AznameResource) ModifyPlan(ctx context.Context, req resource.ModifyPlan
 		}
 	}
 
+	// A changed regenerate_random asks for a new random suffix
+	regenerateRandom := !req.State.Raw.IsNull() && !plan.RegenerateRandom.Equal(state.RegenerateRandom)
+
 	// If result already exists in state and is known, preserve it
-	// (only regenerate if creating or if result is unknown)
-	if !state.Result.IsNull() && !state.Result.IsUnknown() {
+	// (only regenerate if creating, if result is unknown or if a new random
+	// suffix is requested)
+	if !state.Result.IsNull() && !state.Result.IsUnknown() && !regenerateRandom {
 		plan.Result = state.Result
 		plan.ID = state.ID
+		plan.RandomSuffix = r.storedRandomSuffix(ctx, state)
+
+		// custom_name is checked on every plan, as it is when the name is created
+		resp.Diagnostics.Append(checkCustomName(plan.AznameNameModel)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Check whether the inputs still generate the stored name
+		if req.Config.Raw.IsFullyKnown() {
+			r.reconcileResult(ctx, &plan, &resp.Diagnostics)
+		} else {
+			// The desired name can only be determined during apply
+			if plan.RegenerateOnChange.ValueBool() {
+				plan.Result = types.StringUnknown()
+				plan.ID = types.StringUnknown()
+				plan.RandomSuffix = types.StringUnknown()
+			}
+			plan.DesiredResult = types.StringUnknown()
+			plan.Drifted = types.BoolUnknown()
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
 		resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
 		return
 	}
 
 	// If custom_name is provided, use that
 	if !plan.CustomName.IsNull() {
+		resp.Diagnostics.Append(checkCustomName(plan.AznameNameModel)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
 		plan.Result = plan.CustomName
 		plan.ID = plan.CustomName
+		plan.RandomSuffix = types.StringNull()
+		setDesiredResult(&plan, plan.Result.ValueString(), true)
 		resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
 		return
 	}
 
+	// Reuse the stored random suffix, so regenerating the name keeps it
+	var randomSuffix string
+	if !regenerateRandom {
+		randomSuffix = state.RandomSuffix.ValueString()
+	}
+
 	// Check if this resource type requires randomness and no seed is provided
 	needsRandom, diags := NeedsRandomGeneration(ctx, plan.AznameNameModel)
 	resp.Diagnostics.Append(diags...)
@@ -266,17 +490,22 @@ func (r *AznameResource) ModifyPlan(ctx context.Context, req resource.ModifyPlan
 	}
 
 	// If the resource needs random generation without a seed, mark result as unknown
-	// This prevents inconsistent plan errors since random values would differ between plan and apply
-	if needsRandom {
+	// This prevents inconsistent plan errors since random values would differ between plan and apply.
+	// The same applies to inputs only known during apply, such as a context
+	// built from other resources.
+	if (needsRandom && randomSuffix == "") || !req.Config.Raw.IsFullyKnown() {
 		plan.Result = types.StringUnknown()
 		plan.ID = types.StringUnknown()
+		plan.RandomSuffix = types.StringUnknown()
+		plan.DesiredResult = types.StringUnknown()
+		plan.Drifted = types.BoolValue(false)
 		resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
 		return
 	}
 
 	// Generate the name during planning so it's visible in terraform plan
 	config := *r.config
-	result, diags := GenerateName(ctx, plan.AznameNameModel, config)
+	result, randomSuffix, diags := generateName(ctx, plan.AznameNameModel, config, randomSuffix)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -284,9 +513,161 @@ func (r *AznameResource) ModifyPlan(ctx context.Context, req resource.ModifyPlan
 
 	plan.Result = types.StringValue(result)
 	plan.ID = types.StringValue(result)
+	plan.RandomSuffix = suffixValue(randomSuffix)
+	setDesiredResult(&plan, result, true)
 	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
 }
 
+// storedRandomSuffix returns the random suffix stored in state. State written
+// before random_suffix was added has none, in which case the suffix is parsed
+// from the stored name so that regenerating the name keeps it.
+func (r *AznameResource) storedRandomSuffix(ctx context.Context, state AznameResourceModel) types.String {
+	if !state.RandomSuffix.IsNull() || !state.CustomName.IsNull() || r.config == nil {
+		return state.RandomSuffix
+	}
+
+	// seeded suffixes are derived from the seed, so only unseeded ones are recovered
+	needsRandom, diags := NeedsRandomGeneration(ctx, state.AznameNameModel)
+	if diags.HasError() || !needsRandom {
+		return state.RandomSuffix
+	}
+
+	parsed, ok, _ := parseName(ctx, state.Result.ValueString(), state.AznameNameModel, *r.config)
+	if !ok {
+		return state.RandomSuffix
+	}
+	return suffixValue(parsed.values["rand"])
+}
+
+// desiredResult returns the name the planned inputs generate, reusing the
+// stored random suffix, along with the suffix used. The name cannot be known
+// when an unseeded random suffix is needed but none has been stored, in which
+// case ok is false.
+func (r *AznameResource) desiredResult(ctx context.Context, plan AznameResourceModel) (result string, randomSuffix string, ok bool, diags diag.Diagnostics) {
+	// ModifyPlan reports the errors of custom_name on every plan
+	if !plan.CustomName.IsNull() {
+		return plan.CustomName.ValueString(), "", !checkCustomName(plan.AznameNameModel).HasError(), diags
+	}
+
+	needsRandom, diags := NeedsRandomGeneration(ctx, plan.AznameNameModel)
+	if diags.HasError() || (needsRandom && plan.RandomSuffix.ValueString() == "") {
+		return "", "", false, diags
+	}
+
+	result, randomSuffix, diags = generateName(ctx, plan.AznameNameModel, *r.config, plan.RandomSuffix.ValueString())
+	return result, randomSuffix, !diags.HasError(), diags
+}
+
+// reconcileResult compares the stored name in the plan with the name the
+// current inputs generate. With regenerate_on_change the plan is updated to
+// the new name, otherwise a warning shows the difference.
+func (r *AznameResource) reconcileResult(ctx context.Context, plan *AznameResourceModel, diags *diag.Diagnostics) {
+	regenerate := plan.RegenerateOnChange.ValueBool()
+
+	desired, randomSuffix, ok, generateDiags := r.desiredResult(ctx, *plan)
+	setDesiredResult(plan, desired, ok)
+	if !ok || desired == plan.Result.ValueString() {
+		// generation errors only matter when the name is about to change
+		if regenerate && generateDiags.HasError() {
+			diags.Append(generateDiags...)
+		}
+		return
+	}
+
+	if regenerate {
+		diags.Append(generateDiags...)
+		plan.Result = types.StringValue(desired)
+		plan.ID = types.StringValue(desired)
+		plan.RandomSuffix = suffixValue(randomSuffix)
+		setDesiredResult(plan, desired, ok)
+		return
+	}
+
+	diags.AddAttributeWarning(
+		path.Root("result"),
+		"Name inputs changed",
+		fmt.Sprintf("The stored name %q no longer matches the name %q generated from the current configuration. The stored name is kept; set regenerate_on_change to update it in place, or change triggers to replace it.", plan.Result.ValueString(), desired),
+	)
+}
+
+// setDesiredResult sets desired_result and drifted, comparing the desired name
+// with the result. Both are null when the desired name is unknown.
+func setDesiredResult(model *AznameResourceModel, desired string, ok bool) {
+	if !ok {
+		model.DesiredResult = types.StringNull()
+		model.Drifted = types.BoolNull()
+		return
+	}
+
+	model.DesiredResult = types.StringValue(desired)
+	model.Drifted = types.BoolValue(desired != model.Result.ValueString())
+}
+
+// suffixValue converts a random suffix to its attribute value, which is null
+// for names without a random suffix.
+func suffixValue(randomSuffix string) types.String {
+	if randomSuffix == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(randomSuffix)
+}
+
+// ImportState imports an existing name. With an ID of the form
+// resource_type:name, the name is parsed against the active template to
+// restore its inputs. Names that cannot be parsed are kept as custom_name.
 func (r *AznameResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if r.config == nil {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	name := req.ID
+	var resourceType string
+	if before, after, found := strings.Cut(req.ID, ":"); found {
+		resourceType, name = before, after
+	}
+
+	state := AznameResourceModel{
+		AznameNameModel:    newNameModel(resourceType),
+		Triggers:           types.MapNull(types.StringType),
+		RandomSuffix:       types.StringNull(),
+		RegenerateRandom:   types.StringNull(),
+		RegenerateOnChange: types.BoolNull(),
+	}
+
+	parsed := false
+	if resourceType != "" {
+		parsedName, ok, diags := parseName(ctx, name, state.AznameNameModel, *r.config)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if ok {
+			state.AznameNameModel = parsedName.model
+			state.RandomSuffix = suffixValue(parsedName.values["rand"])
+			parsed = true
+		}
+	} else {
+		state.ResourceType = types.StringNull()
+	}
+
+	if !parsed {
+		reason := fmt.Sprintf("The name %q does not match the template for %s", name, resourceType)
+		if resourceType == "" {
+			reason = fmt.Sprintf("No resource type was given for %q; import it as <resource_type>:<name> to restore the inputs of names that follow the naming convention", name)
+		}
+
+		state.CustomName = types.StringValue(name)
+		detail := reason + ", so it was imported as custom_name."
+		if resourceType != "" && checkCustomName(state.AznameNameModel).HasError() {
+			detail += " The name also breaks the naming rules of the resource type; set skip_validation to keep it."
+		}
+		resp.Diagnostics.AddWarning("Imported name kept as custom_name", detail)
+	}
+
+	state.Result = types.StringValue(name)
+	state.ID = types.StringValue(name)
+	setDesiredResult(&state, name, true)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
@@ -1,12 +1,16 @@
 package provider
 
 import (
+	"fmt"
 	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-testing/compare"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 )
 
@@ -169,6 +173,75 @@ func TestNameResource_CustomName(t *testing.T) {
 	})
 }
 
+func TestNameResource_CustomNameValidation(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// custom_name is checked against the resource type rules
+			{
+				Config: providerConfig + `
+					resource "azname_name" "custom" {
+						name          = "myapp"
+						resource_type = "azurerm_storage_account"
+						custom_name   = "Legacy-Storage"
+					}
+					`,
+				ExpectError: regexp.MustCompile(`Invalid custom name`),
+			},
+			// skip_validation allows legacy names that break the rules
+			{
+				Config: providerConfig + `
+					resource "azname_name" "custom" {
+						name            = "myapp"
+						resource_type   = "azurerm_storage_account"
+						custom_name     = "Legacy-Storage"
+						skip_validation = true
+					}
+					`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("azname_name.custom", "result", "Legacy-Storage"),
+				),
+			},
+			// The stored custom_name is checked again on every plan
+			{
+				Config: providerConfig + `
+					resource "azname_name" "custom" {
+						name          = "myapp"
+						resource_type = "azurerm_storage_account"
+						custom_name   = "Legacy-Storage"
+					}
+					`,
+				ExpectError: regexp.MustCompile(`Invalid custom name`),
+			},
+		},
+	})
+}
+
+func TestNameResource_CustomNameOverrideResourceType(t *testing.T) {
+	testAccOverrides(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Resource types from the overrides file only check their length, case and dashes
+			{
+				Config: providerConfig + `
+					resource "azname_name" "custom" {
+						name          = "myapp"
+						resource_type = "azname_test_widget"
+						custom_name   = "legacy-widget_01"
+					}
+					`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("azname_name.custom", "result", "legacy-widget_01"),
+				),
+			},
+		},
+	})
+}
+
 func TestNameResource_Triggers(t *testing.T) {
 	resource.UnitTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -309,3 +382,518 @@ func TestNameResource_Validation(t *testing.T) {
 		},
 	})
 }
+
+func TestNameResource_NamedTemplates(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Named and inline templates, falling back to the default template
+			{
+				Config: `
+					provider "azname" {
+						random_length = 3
+						templates = {
+							network = "{resource_type}~{environment}~{location}{instance}"
+						}
+					}
+					resource "azname_name" "vnet" {
+						name          = "myapp"
+						environment   = "prod"
+						resource_type = "azurerm_virtual_network"
+						location      = "Australia East"
+						template_name = "network"
+					}
+					resource "azname_name" "inline" {
+						name          = "myapp"
+						resource_type = "azurerm_virtual_network"
+						template      = "{workload}~{resource_type}"
+					}
+					resource "azname_name" "default" {
+						name          = "myapp"
+						environment   = "prod"
+						resource_type = "azurerm_virtual_network"
+						location      = "Australia East"
+					}
+					`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("azname_name.vnet", "result", "vnet-prod-ae"),
+					resource.TestCheckResourceAttr("azname_name.inline", "result", "myapp-vnet"),
+					resource.TestCheckResourceAttr("azname_name.default", "result", "vnet-myapp-prod-ae"),
+				),
+			},
+			// Unknown template names are rejected
+			{
+				Config: `
+					provider "azname" {
+						random_length = 3
+						templates = {
+							network = "{resource_type}~{environment}~{location}{instance}"
+						}
+					}
+					resource "azname_name" "vnet" {
+						name          = "myapp"
+						resource_type = "azurerm_virtual_network"
+						template_name = "netwrk"
+					}
+					`,
+				ExpectError: regexp.MustCompile(`Template "netwrk" is not defined`),
+			},
+		},
+	})
+}
+
+func TestNameResource_UniqueFrom(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The suffix is derived from unique_from, so it is known during plan
+			{
+				Config: `
+					provider "azname" {
+						random_length = 3
+					}
+					resource "azname_name" "storage" {
+						name          = "myapp"
+						environment   = "prod"
+						resource_type = "azurerm_storage_account"
+						location      = "East US"
+						unique_from   = ["00000000-0000-0000-0000-000000000000"]
+					}
+					`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectKnownValue(
+							"azname_name.storage",
+							tfjsonpath.New("result"),
+							knownvalue.StringExact("stmyappprodeus569"),
+						),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("azname_name.storage", "result", "stmyappprodeus569"),
+				),
+			},
+			// unique_from and random_seed cannot be combined
+			{
+				Config: `
+					resource "azname_name" "storage" {
+						name          = "myapp"
+						resource_type = "azurerm_storage_account"
+						unique_from   = ["00000000-0000-0000-0000-000000000000"]
+						random_seed   = 999
+					}
+					`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func TestNameResource_Random(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// random = "always" adds a suffix to non-global resource types,
+			// which is unknown during plan without a seed
+			{
+				Config: `
+					provider "azname" {
+						random_length = 3
+					}
+					resource "azname_name" "rg" {
+						name          = "myapp"
+						environment   = "prod"
+						resource_type = "azurerm_resource_group"
+						location      = "East US"
+						random        = "always"
+					}
+					resource "azname_name" "seeded" {
+						name          = "myapp"
+						environment   = "prod"
+						resource_type = "azurerm_resource_group"
+						location      = "East US"
+						random        = "always"
+						random_seed   = 999
+					}
+					resource "azname_name" "storage" {
+						name          = "myapp"
+						environment   = "prod"
+						resource_type = "azurerm_storage_account"
+						location      = "East US"
+						random        = "never"
+					}
+					`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectUnknownValue("azname_name.rg", tfjsonpath.New("result")),
+						plancheck.ExpectKnownValue(
+							"azname_name.seeded",
+							tfjsonpath.New("result"),
+							knownvalue.StringExact("rg-myapp-prod-eus415"),
+						),
+						plancheck.ExpectKnownValue(
+							"azname_name.storage",
+							tfjsonpath.New("result"),
+							knownvalue.StringExact("stmyappprodeus"),
+						),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("azname_name.rg", "result", regexp.MustCompile(`^rg-myapp-prod-eus\d{3}$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestNameResource_RandomSuffix(t *testing.T) {
+	suffixChanges := statecheck.CompareValue(compare.ValuesDiffer())
+
+	config := func(regenerate string) string {
+		return `
+			provider "azname" {
+				random_length  = 6
+				random_charset = "alnum"
+			}
+			resource "azname_name" "storage" {
+				name              = "myapp"
+				environment       = "prod"
+				resource_type     = "azurerm_storage_account"
+				regenerate_random = "` + regenerate + `"
+			}
+			`
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The random suffix is stored alongside the result
+			{
+				Config: config("1"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					suffixChanges.AddStateValue("azname_name.storage", tfjsonpath.New("random_suffix")),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("azname_name.storage", "random_suffix", regexp.MustCompile(`^[0-9a-z]{6}$`)),
+					resource.TestMatchResourceAttr("azname_name.storage", "result", regexp.MustCompile(`^stmyappprod[0-9a-z]{6}$`)),
+				),
+			},
+			// Changing regenerate_random draws a new suffix in place
+			{
+				Config: config("2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("azname_name.storage", plancheck.ResourceActionUpdate),
+						plancheck.ExpectUnknownValue("azname_name.storage", tfjsonpath.New("random_suffix")),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					suffixChanges.AddStateValue("azname_name.storage", tfjsonpath.New("random_suffix")),
+				},
+			},
+		},
+	})
+}
+
+func TestNameResource_RandomPadding(t *testing.T) {
+	config := `
+		provider "azname" {
+			random_length = 1
+			pad_strategy  = "random"
+		}
+		resource "azname_name" "aa" {
+			name          = "a"
+			resource_type = "azurerm_automation_account"
+			template      = "{resource_type}{workload}{rand}"
+			random        = "always"
+		}
+		`
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The name is padded with random digits after its suffix
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("azname_name.aa", "result", regexp.MustCompile(`^aaa\d{3}$`)),
+				),
+			},
+			// Reusing the stored suffix pads the name the same way
+			{
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestNameResource_CrockfordSuffix(t *testing.T) {
+	suffixKept := statecheck.CompareValue(compare.ValuesSame())
+
+	config := func(environment string) string {
+		return fmt.Sprintf(`
+			provider "azname" {
+				random_length  = 4
+				random_charset = "crockford"
+			}
+			resource "azname_name" "storage" {
+				name                 = "myapp"
+				environment          = %q
+				resource_type        = "azurerm_storage_account"
+				regenerate_on_change = true
+			}
+			`, environment)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The suffix is stored in the case of the lowercase-only name
+			{
+				Config: config("dev"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					suffixKept.AddStateValue("azname_name.storage", tfjsonpath.New("random_suffix")),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("azname_name.storage", "random_suffix", regexp.MustCompile(`^[0-9a-hjkmnp-tv-z]{4}$`)),
+					resource.TestMatchResourceAttr("azname_name.storage", "result", regexp.MustCompile(`^stmyappdev[0-9a-hjkmnp-tv-z]{4}$`)),
+				),
+			},
+			// The lowercase suffix is reused when the name is regenerated
+			{
+				Config: config("prod"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					suffixKept.AddStateValue("azname_name.storage", tfjsonpath.New("random_suffix")),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("azname_name.storage", "result", regexp.MustCompile(`^stmyappprod[0-9a-hjkmnp-tv-z]{4}$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestNameResource_RegenerateOnChange(t *testing.T) {
+	suffixKept := statecheck.CompareValue(compare.ValuesSame())
+
+	config := func(environment string, regenerate bool) string {
+		return fmt.Sprintf(`
+			provider "azname" {
+				random_length = 3
+			}
+			resource "azname_name" "rg" {
+				name                 = "myapp"
+				environment          = %[1]q
+				resource_type        = "azurerm_resource_group"
+				regenerate_on_change = %[2]t
+			}
+			resource "azname_name" "storage" {
+				name                 = "myapp"
+				environment          = %[1]q
+				resource_type        = "azurerm_storage_account"
+				regenerate_on_change = %[2]t
+			}
+			`, environment, regenerate)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("dev", false),
+				ConfigStateChecks: []statecheck.StateCheck{
+					suffixKept.AddStateValue("azname_name.storage", tfjsonpath.New("random_suffix")),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("azname_name.rg", "result", "rg-myapp-dev"),
+				),
+			},
+			// By default the stored name is kept when the inputs change
+			{
+				Config: config("prod", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("azname_name.rg", "result", "rg-myapp-dev"),
+					resource.TestMatchResourceAttr("azname_name.storage", "result", regexp.MustCompile(`^stmyappdev\d{3}$`)),
+				),
+			},
+			// regenerate_on_change updates the name in place, keeping the suffix
+			{
+				Config: config("prod", true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("azname_name.rg", plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue(
+							"azname_name.rg",
+							tfjsonpath.New("result"),
+							knownvalue.StringExact("rg-myapp-prod"),
+						),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					suffixKept.AddStateValue("azname_name.storage", tfjsonpath.New("random_suffix")),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("azname_name.rg", "result", "rg-myapp-prod"),
+					resource.TestMatchResourceAttr("azname_name.storage", "result", regexp.MustCompile(`^stmyappprod\d{3}$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestNameResource_RegenerateOnChangeUnknown(t *testing.T) {
+	suffixKept := statecheck.CompareValue(compare.ValuesSame())
+
+	config := func(environment string) string {
+		return fmt.Sprintf(`
+			provider "azname" {
+				random_length = 3
+			}
+			resource "terraform_data" "environment" {
+				input = %[1]q
+			}
+			resource "azname_name" "storage" {
+				name                 = "myapp"
+				environment          = terraform_data.environment.output
+				resource_type        = "azurerm_storage_account"
+				regenerate_on_change = true
+			}
+			`, environment)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("dev"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					suffixKept.AddStateValue("azname_name.storage", tfjsonpath.New("random_suffix")),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("azname_name.storage", "result", regexp.MustCompile(`^stmyappdev\d{3}$`)),
+				),
+			},
+			// An input only known during apply leaves the name and its suffix
+			// unknown in the plan, and the stored suffix is kept during apply
+			{
+				Config: config("prod"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectUnknownValue("azname_name.storage", tfjsonpath.New("result")),
+						plancheck.ExpectUnknownValue("azname_name.storage", tfjsonpath.New("random_suffix")),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					suffixKept.AddStateValue("azname_name.storage", tfjsonpath.New("random_suffix")),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("azname_name.storage", "result", regexp.MustCompile(`^stmyappprod\d{3}$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestNameResource_Drift(t *testing.T) {
+	config := func(environment string) string {
+		return fmt.Sprintf(`
+			resource "azname_name" "rg" {
+				name          = "myapp"
+				environment   = %q
+				resource_type = "azurerm_resource_group"
+			}
+			`, environment)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("dev"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("azname_name.rg", "result", "rg-myapp-dev"),
+					resource.TestCheckResourceAttr("azname_name.rg", "desired_result", "rg-myapp-dev"),
+					resource.TestCheckResourceAttr("azname_name.rg", "drifted", "false"),
+				),
+			},
+			// The stored name is kept, but the drift is visible
+			{
+				Config: config("prod"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectKnownValue(
+							"azname_name.rg",
+							tfjsonpath.New("desired_result"),
+							knownvalue.StringExact("rg-myapp-prod"),
+						),
+						plancheck.ExpectKnownValue("azname_name.rg", tfjsonpath.New("drifted"), knownvalue.Bool(true)),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("azname_name.rg", "result", "rg-myapp-dev"),
+					resource.TestCheckResourceAttr("azname_name.rg", "desired_result", "rg-myapp-prod"),
+					resource.TestCheckResourceAttr("azname_name.rg", "drifted", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestNameResource_Import(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "azname_name" "test" {
+						name          = "myapp"
+						environment   = "dev"
+						resource_type = "azurerm_resource_group"
+						location      = "westus2"
+						instance      = 1
+					}
+					`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("azname_name.test", "result", "rg-myapp-dev-wus2001"),
+				),
+			},
+			// The inputs are restored by parsing the name against the template
+			{
+				ResourceName:      "azname_name.test",
+				ImportState:       true,
+				ImportStateId:     "azurerm_resource_group:rg-myapp-dev-wus2001",
+				ImportStateVerify: true,
+			},
+			// Names that do not follow the template are imported as custom_name
+			{
+				ResourceName:  "azname_name.test",
+				ImportState:   true,
+				ImportStateId: "azurerm_resource_group:legacy_rg_01",
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if got := states[0].Attributes["custom_name"]; got != "legacy_rg_01" {
+						return fmt.Errorf("expected custom_name legacy_rg_01, got %q", got)
+					}
+					if got := states[0].Attributes["result"]; got != "legacy_rg_01" {
+						return fmt.Errorf("expected result legacy_rg_01, got %q", got)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
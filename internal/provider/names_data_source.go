@@ -0,0 +1,192 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &AznameNamesDataSource{}
+	_ datasource.DataSourceWithConfigure = &AznameNamesDataSource{}
+)
+
+func NewAznameNamesDataSource() datasource.DataSource {
+	return &AznameNamesDataSource{}
+}
+
+type AznameNamesDataSource struct {
+	config *AznameProviderModel
+}
+
+func (d *AznameNamesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Always perform a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*AznameProviderModel)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *AznameProviderModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.config = config
+}
+
+func (d *AznameNamesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_names"
+}
+
+func (d *AznameNamesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Data source for generating a set of standardized Azure resource names that share the same workload inputs.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "ID of the data source, same as name.",
+				MarkdownDescription: "ID of the data source, same as `name`.",
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				Description:         "The workload or application name to use in every resource name.",
+				MarkdownDescription: "The workload or application name to use in every resource name.",
+			},
+			"environment": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The environment name (e.g., dev, test, prod) to use in every resource name.",
+				MarkdownDescription: "The environment name (e.g., dev, test, prod) to use in every resource name. Defaults to provider-level environment if not set.",
+			},
+			"prefixes": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				Description:         "List of prefixes to prepend to every resource name.",
+				MarkdownDescription: "List of prefixes to prepend to every resource name. These will be joined using the separator character.",
+			},
+			"suffixes": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				Description:         "List of suffixes to append to every resource name.",
+				MarkdownDescription: "List of suffixes to append to every resource name. These will be joined using the separator character.",
+			},
+			"separator": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(1),
+				},
+				Description:         "Character to use as separator in the resource names. Defaults to provider's separator setting.",
+				MarkdownDescription: "Character to use as separator in the resource names. Must be a single character. Defaults to provider's separator setting.",
+			},
+			"random_seed": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "Seed value for random suffix generation. Use this to get consistent random values.",
+				MarkdownDescription: "Seed value for random suffix generation. Use this to get consistent, deterministic random values. Every name drawing a random suffix gets the same suffix.",
+			},
+			"location": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Azure region where the resources will be deployed.",
+				MarkdownDescription: "Azure region where the resources will be deployed. Can be overridden for each name.",
+			},
+			"truncate_strategy": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("segment", "hash"),
+				},
+				Description:         "How to shorten names that exceed the maximum length. Defaults to provider's truncate_strategy setting.",
+				MarkdownDescription: "How to shorten names that exceed the resource type maximum length: `segment` or `hash`. Defaults to provider's `truncate_strategy` setting.",
+			},
+			"components": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				Description:         "Map of values for custom template tokens, merged over the provider's components.",
+				MarkdownDescription: "Map of values for custom template tokens, merged over the provider's `components`. Each name can add or override components.",
+			},
+			"random_charset": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(randomCharsetNames...),
+				},
+				Description:         "Characters to draw random suffixes from. Defaults to provider's random_charset setting.",
+				MarkdownDescription: "Characters to draw `{rand}` suffixes from: `numeric`, `hex`, `alnum` or `crockford`. Defaults to provider's `random_charset` setting.",
+			},
+			"unique_from": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ConflictsWith(path.MatchRoot("random_seed")),
+				},
+				Description:         "Values to derive a stable random suffix from, such as a subscription or resource group ID.",
+				MarkdownDescription: "Values to derive a stable random suffix from, such as a subscription, resource group or tenant ID, similar to ARM's `uniqueString()`. Every name drawing a random suffix gets the same suffix. Conflicts with `random_seed`.",
+			},
+			"random": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("auto", "always", "never"),
+				},
+				Description:         "Whether to generate the random suffix: auto, always or never. Default: auto",
+				MarkdownDescription: "Whether to generate the `{rand}` suffix: `auto` (default) only for global-scope resource types, `always` or `never`. Can be overridden for each name.",
+			},
+			"names": schema.MapNestedAttribute{
+				Required: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: namesEntryDataSourceAttributes(),
+				},
+				Description:         "Map of names to generate, keyed by an arbitrary identifier.",
+				MarkdownDescription: "Map of names to generate, keyed by an arbitrary identifier. Each entry takes the resource type and the inputs that differ between names.",
+			},
+			"results": schema.MapAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				Description:         "The generated names, keyed like names.",
+				MarkdownDescription: "The generated names, keyed like `names`.",
+			},
+		},
+	}
+}
+
+func (d *AznameNamesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state AznameNamesModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entries, diags := namesEntries(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every name is generated, so all failing names are reported at once
+	results := map[string]attr.Value{}
+	for _, key := range slices.Sorted(maps.Keys(entries)) {
+		result, diags := generateNamesEntry(ctx, namesEntryModel(state, entries[key]), *d.config)
+		resp.Diagnostics.Append(namesEntryDiagnostics(key, diags)...)
+		results[key] = types.StringValue(result)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ID = types.StringValue(state.Name.ValueString())
+	state.Results = types.MapValueMust(types.StringType, results)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestNamesDataSource(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+					data "azname_names" "test" {
+						name        = "test"
+						environment = "tst"
+						location    = "Australia East"
+						prefixes    = ["unit"]
+						random_seed = 123
+
+						names = {
+							rg = {
+								resource_type = "azurerm_resource_group"
+								instance      = 1
+							}
+							storage = {
+								resource_type = "azurerm_storage_account"
+							}
+							subnet = {
+								resource_type = "azurerm_subnet"
+								service       = "web"
+								location      = "westus2"
+							}
+							legacy = {
+								resource_type = "azurerm_resource_group"
+								custom_name   = "legacy-rg"
+							}
+						}
+					}
+					`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.azname_names.test", "results.%", "4"),
+					resource.TestCheckResourceAttr("data.azname_names.test", "results.rg", "unit-rg-test-tst-ae001"),
+					resource.TestCheckResourceAttr("data.azname_names.test", "results.storage", "unitsttesttstae851"),
+					resource.TestCheckResourceAttr("data.azname_names.test", "results.subnet", "unit-snet-test-tst-web-wus2"),
+					resource.TestCheckResourceAttr("data.azname_names.test", "results.legacy", "legacy-rg"),
+				),
+			},
+		},
+	})
+}
+
+func TestNamesDataSourceErrors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Every failing name is reported with its key
+			{
+				Config: providerConfig + `
+					data "azname_names" "test" {
+						name = "test"
+
+						names = {
+							good = {
+								resource_type = "azurerm_resource_group"
+							}
+							typo = {
+								resource_type = "azurerm_resource_grop"
+							}
+							region = {
+								resource_type = "azurerm_resource_group"
+								location      = "nowhere"
+							}
+						}
+					}
+					`,
+				ExpectError: regexp.MustCompile(`(?s)Name "region".*Name "typo"`),
+			},
+		},
+	})
+}
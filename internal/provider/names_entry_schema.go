@@ -0,0 +1,208 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// namesEntryAttribute is an attribute of an entry in names. The data source
+// and the resource build their entry schemas from the same list, so each
+// attribute converts itself to the attribute types of both schema packages.
+type namesEntryAttribute interface {
+	dataSourceAttribute() datasourceschema.Attribute
+	resourceAttribute() resourceschema.Attribute
+}
+
+// namesEntryString is a string attribute of an entry in names.
+type namesEntryString struct {
+	required            bool
+	validators          []validator.String
+	description         string
+	markdownDescription string
+}
+
+func (a namesEntryString) dataSourceAttribute() datasourceschema.Attribute {
+	return datasourceschema.StringAttribute{
+		Required:            a.required,
+		Optional:            !a.required,
+		Validators:          a.validators,
+		Description:         a.description,
+		MarkdownDescription: a.markdownDescription,
+	}
+}
+
+func (a namesEntryString) resourceAttribute() resourceschema.Attribute {
+	return resourceschema.StringAttribute{
+		Required:            a.required,
+		Optional:            !a.required,
+		Validators:          a.validators,
+		Description:         a.description,
+		MarkdownDescription: a.markdownDescription,
+	}
+}
+
+// namesEntryInt64 is a number attribute of an entry in names.
+type namesEntryInt64 struct {
+	required            bool
+	validators          []validator.Int64
+	description         string
+	markdownDescription string
+}
+
+func (a namesEntryInt64) dataSourceAttribute() datasourceschema.Attribute {
+	return datasourceschema.Int64Attribute{
+		Required:            a.required,
+		Optional:            !a.required,
+		Validators:          a.validators,
+		Description:         a.description,
+		MarkdownDescription: a.markdownDescription,
+	}
+}
+
+func (a namesEntryInt64) resourceAttribute() resourceschema.Attribute {
+	return resourceschema.Int64Attribute{
+		Required:            a.required,
+		Optional:            !a.required,
+		Validators:          a.validators,
+		Description:         a.description,
+		MarkdownDescription: a.markdownDescription,
+	}
+}
+
+// namesEntryBool is a boolean attribute of an entry in names.
+type namesEntryBool struct {
+	required            bool
+	validators          []validator.Bool
+	description         string
+	markdownDescription string
+}
+
+func (a namesEntryBool) dataSourceAttribute() datasourceschema.Attribute {
+	return datasourceschema.BoolAttribute{
+		Required:            a.required,
+		Optional:            !a.required,
+		Validators:          a.validators,
+		Description:         a.description,
+		MarkdownDescription: a.markdownDescription,
+	}
+}
+
+func (a namesEntryBool) resourceAttribute() resourceschema.Attribute {
+	return resourceschema.BoolAttribute{
+		Required:            a.required,
+		Optional:            !a.required,
+		Validators:          a.validators,
+		Description:         a.description,
+		MarkdownDescription: a.markdownDescription,
+	}
+}
+
+// namesEntryMap is a map of strings attribute of an entry in names.
+type namesEntryMap struct {
+	required            bool
+	validators          []validator.Map
+	description         string
+	markdownDescription string
+}
+
+func (a namesEntryMap) dataSourceAttribute() datasourceschema.Attribute {
+	return datasourceschema.MapAttribute{
+		Required:            a.required,
+		Optional:            !a.required,
+		ElementType:         types.StringType,
+		Validators:          a.validators,
+		Description:         a.description,
+		MarkdownDescription: a.markdownDescription,
+	}
+}
+
+func (a namesEntryMap) resourceAttribute() resourceschema.Attribute {
+	return resourceschema.MapAttribute{
+		Required:            a.required,
+		Optional:            !a.required,
+		ElementType:         types.StringType,
+		Validators:          a.validators,
+		Description:         a.description,
+		MarkdownDescription: a.markdownDescription,
+	}
+}
+
+// namesEntryAttributes are the attributes of an entry in names, keyed by
+// attribute name.
+var namesEntryAttributes = map[string]namesEntryAttribute{
+	"resource_type": namesEntryString{
+		required:            true,
+		description:         "The Azure resource type, as an azurerm type name, CAF slug or resource provider namespace.",
+		markdownDescription: "The Azure resource type, as an azurerm type name (e.g., `azurerm_key_vault`), CAF slug (e.g., `kv`) or Azure resource provider namespace (e.g., `Microsoft.KeyVault/vaults`).",
+	},
+	"service": namesEntryString{
+		description:         "Service or component identifier within the workload.",
+		markdownDescription: "Service or component identifier within the workload (e.g., web, api, worker).",
+	},
+	"instance": namesEntryInt64{
+		description:         "Instance number for the resource.",
+		markdownDescription: "Instance number for the resource. Used when deploying multiple instances of the same resource type.",
+	},
+	"parent_name": namesEntryString{
+		description:         "Name of the parent resource for child resources.",
+		markdownDescription: "Name of the parent resource. Required when generating names for child resources.",
+	},
+	"location": namesEntryString{
+		description:         "Azure region of the resource, overriding the shared location.",
+		markdownDescription: "Azure region of the resource, overriding the shared `location`.",
+	},
+	"template_name": namesEntryString{
+		description:         "Name of a template from the provider's templates map to generate the name with.",
+		markdownDescription: "Name of a template from the provider's `templates` map to generate the name with.",
+	},
+	"template": namesEntryString{
+		validators: []validator.String{
+			stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("template_name")),
+		},
+		description:         "Inline template to generate the name with, instead of the provider templates.",
+		markdownDescription: "Inline template to generate the name with, instead of the provider templates. Conflicts with `template_name`.",
+	},
+	"components": namesEntryMap{
+		description:         "Map of values for custom template tokens, merged over the shared components.",
+		markdownDescription: "Map of values for custom template tokens, merged over the shared `components`.",
+	},
+	"random": namesEntryString{
+		validators: []validator.String{
+			stringvalidator.OneOf("auto", "always", "never"),
+		},
+		description:         "Whether to generate the random suffix, overriding the shared random.",
+		markdownDescription: "Whether to generate the `{rand}` suffix: `auto`, `always` or `never`, overriding the shared `random`.",
+	},
+	"custom_name": namesEntryString{
+		description:         "Override the generated name with a custom value.",
+		markdownDescription: "Override the generated name with a custom value. The name is checked against the rules of the resource type unless `skip_validation` is set.",
+	},
+	"skip_validation": namesEntryBool{
+		description:         "Skip the resource type naming rules when checking custom_name.",
+		markdownDescription: "Skip the resource type naming rules when checking `custom_name`. Default: false",
+	},
+}
+
+// namesEntryDataSourceAttributes returns the attributes of an entry in names
+// for the data source schema.
+func namesEntryDataSourceAttributes() map[string]datasourceschema.Attribute {
+	attributes := map[string]datasourceschema.Attribute{}
+	for name, attribute := range namesEntryAttributes {
+		attributes[name] = attribute.dataSourceAttribute()
+	}
+	return attributes
+}
+
+// namesEntryResourceAttributes returns the attributes of an entry in names
+// for the resource schema.
+func namesEntryResourceAttributes() map[string]resourceschema.Attribute {
+	attributes := map[string]resourceschema.Attribute{}
+	for name, attribute := range namesEntryAttributes {
+		attributes[name] = attribute.resourceAttribute()
+	}
+	return attributes
+}
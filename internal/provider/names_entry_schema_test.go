@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func TestNamesEntryAttributes(t *testing.T) {
+	ctx := context.Background()
+	dataSourceAttributes := namesEntryDataSourceAttributes()
+	resourceAttributes := namesEntryResourceAttributes()
+
+	for name, attribute := range namesEntryAttributes {
+		var required bool
+		var validators []string
+		switch attribute := attribute.(type) {
+		case namesEntryString:
+			required, validators = attribute.required, validatorDescriptions(ctx, attribute.validators)
+		case namesEntryInt64:
+			required, validators = attribute.required, validatorDescriptions(ctx, attribute.validators)
+		case namesEntryBool:
+			required, validators = attribute.required, validatorDescriptions(ctx, attribute.validators)
+		case namesEntryMap:
+			required, validators = attribute.required, validatorDescriptions(ctx, attribute.validators)
+		default:
+			t.Fatalf("%s: unexpected attribute type %T", name, attribute)
+		}
+
+		schemas := map[string]any{
+			"data source": dataSourceAttributes[name],
+			"resource":    resourceAttributes[name],
+		}
+		for schemaName, schemaAttribute := range schemas {
+			if schemaAttribute == nil {
+				t.Errorf("%s: missing from %s schema", name, schemaName)
+				continue
+			}
+			gotRequired, gotValidators := schemaAttributeRules(ctx, schemaAttribute)
+			if gotRequired != required {
+				t.Errorf("%s: %s schema required = %t, want %t", name, schemaName, gotRequired, required)
+			}
+			if !slices.Equal(gotValidators, validators) {
+				t.Errorf("%s: %s schema validators = %q, want %q", name, schemaName, gotValidators, validators)
+			}
+		}
+	}
+}
+
+// schemaAttributeRules returns whether a schema attribute is required and
+// the descriptions of its validators.
+func schemaAttributeRules(ctx context.Context, attribute any) (bool, []string) {
+	required := attribute.(interface{ IsRequired() bool }).IsRequired()
+	switch attribute := attribute.(type) {
+	case interface{ StringValidators() []validator.String }:
+		return required, validatorDescriptions(ctx, attribute.StringValidators())
+	case interface{ Int64Validators() []validator.Int64 }:
+		return required, validatorDescriptions(ctx, attribute.Int64Validators())
+	case interface{ BoolValidators() []validator.Bool }:
+		return required, validatorDescriptions(ctx, attribute.BoolValidators())
+	case interface{ MapValidators() []validator.Map }:
+		return required, validatorDescriptions(ctx, attribute.MapValidators())
+	}
+	return required, nil
+}
+
+// validatorDescriptions returns the descriptions of validators, which tell
+// validators apart for comparison.
+func validatorDescriptions[V validator.Describer](ctx context.Context, validators []V) []string {
+	var descriptions []string
+	for _, v := range validators {
+		descriptions = append(descriptions, v.Description(ctx))
+	}
+	return descriptions
+}
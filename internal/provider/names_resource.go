@@ -0,0 +1,678 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"terraform-provider-azname/internal/resources"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AznameNamesResource{}
+var _ resource.ResourceWithModifyPlan = &AznameNamesResource{}
+
+func NewAznameNamesResource() resource.Resource {
+	return &AznameNamesResource{}
+}
+
+type AznameNamesResource struct {
+	config *AznameProviderModel
+}
+
+// AznameNamesModel holds the inputs shared by every name of azname_names.
+// These are shared between the resource and data source implementations.
+type AznameNamesModel struct {
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Environment      types.String `tfsdk:"environment"`
+	Prefixes         types.List   `tfsdk:"prefixes"`
+	Suffixes         types.List   `tfsdk:"suffixes"`
+	Separator        types.String `tfsdk:"separator"`
+	RandomSeed       types.Int64  `tfsdk:"random_seed"`
+	Location         types.String `tfsdk:"location"`
+	TruncateStrategy types.String `tfsdk:"truncate_strategy"`
+	Components       types.Map    `tfsdk:"components"`
+	RandomCharset    types.String `tfsdk:"random_charset"`
+	UniqueFrom       types.List   `tfsdk:"unique_from"`
+	Random           types.String `tfsdk:"random"`
+	Names            types.Map    `tfsdk:"names"`
+	Results          types.Map    `tfsdk:"results"`
+}
+
+// AznameNamesEntryModel holds the inputs of a single name of azname_names.
+type AznameNamesEntryModel struct {
+	ResourceType   types.String `tfsdk:"resource_type"`
+	Service        types.String `tfsdk:"service"`
+	Instance       types.Int64  `tfsdk:"instance"`
+	ParentName     types.String `tfsdk:"parent_name"`
+	Location       types.String `tfsdk:"location"`
+	TemplateName   types.String `tfsdk:"template_name"`
+	Template       types.String `tfsdk:"template"`
+	Components     types.Map    `tfsdk:"components"`
+	Random         types.String `tfsdk:"random"`
+	CustomName     types.String `tfsdk:"custom_name"`
+	SkipValidation types.Bool   `tfsdk:"skip_validation"`
+}
+
+type AznameNamesResourceModel struct {
+	AznameNamesModel
+	Triggers           types.Map  `tfsdk:"triggers"`
+	RegenerateOnChange types.Bool `tfsdk:"regenerate_on_change"`
+	DesiredResults     types.Map  `tfsdk:"desired_results"`
+	Drifted            types.Map  `tfsdk:"drifted"`
+}
+
+func (r *AznameNamesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_names"
+}
+
+func (r *AznameNamesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource for generating a set of standardized Azure resource names that share the same workload inputs.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "ID of the resource, same as name.",
+				MarkdownDescription: "ID of the resource, same as `name`.",
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				Description:         "The workload or application name to use in every resource name.",
+				MarkdownDescription: "The workload or application name to use in every resource name.",
+			},
+			"environment": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The environment name (e.g., dev, test, prod) to use in every resource name.",
+				MarkdownDescription: "The environment name (e.g., dev, test, prod) to use in every resource name. Defaults to provider-level environment if not set.",
+			},
+			"prefixes": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				Description:         "List of prefixes to prepend to every resource name.",
+				MarkdownDescription: "List of prefixes to prepend to every resource name. These will be joined using the separator character.",
+			},
+			"suffixes": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				Description:         "List of suffixes to append to every resource name.",
+				MarkdownDescription: "List of suffixes to append to every resource name. These will be joined using the separator character.",
+			},
+			"separator": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(1),
+				},
+				Description:         "Character to use as separator in the resource names. Defaults to provider's separator setting.",
+				MarkdownDescription: "Character to use as separator in the resource names. Must be a single character. Defaults to provider's separator setting.",
+			},
+			"random_seed": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "Seed value for random suffix generation. Use this to get consistent random values.",
+				MarkdownDescription: "Seed value for random suffix generation. Use this to get consistent, deterministic random values that are shown in plan output. Every name drawing a random suffix gets the same suffix.",
+			},
+			"location": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Azure region where the resources will be deployed.",
+				MarkdownDescription: "Azure region where the resources will be deployed. Can be overridden for each name.",
+			},
+			"truncate_strategy": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("segment", "hash"),
+				},
+				Description:         "How to shorten names that exceed the maximum length. Defaults to provider's truncate_strategy setting.",
+				MarkdownDescription: "How to shorten names that exceed the resource type maximum length: `segment` or `hash`. Defaults to provider's `truncate_strategy` setting.",
+			},
+			"components": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				Description:         "Map of values for custom template tokens, merged over the provider's components.",
+				MarkdownDescription: "Map of values for custom template tokens, merged over the provider's `components`. Each name can add or override components.",
+			},
+			"random_charset": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(randomCharsetNames...),
+				},
+				Description:         "Characters to draw random suffixes from. Defaults to provider's random_charset setting.",
+				MarkdownDescription: "Characters to draw `{rand}` suffixes from: `numeric`, `hex`, `alnum` or `crockford`. Defaults to provider's `random_charset` setting.",
+			},
+			"unique_from": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ConflictsWith(path.MatchRoot("random_seed")),
+				},
+				Description:         "Values to derive a stable random suffix from, such as a subscription or resource group ID.",
+				MarkdownDescription: "Values to derive a stable random suffix from, such as a subscription, resource group or tenant ID, similar to ARM's `uniqueString()`. Every name drawing a random suffix gets the same suffix. Conflicts with `random_seed`.",
+			},
+			"random": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("auto", "always", "never"),
+				},
+				Description:         "Whether to generate the random suffix: auto, always or never. Default: auto",
+				MarkdownDescription: "Whether to generate the `{rand}` suffix: `auto` (default) only for global-scope resource types, `always` or `never`. Can be overridden for each name.",
+			},
+			"names": schema.MapNestedAttribute{
+				Required: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: namesEntryResourceAttributes(),
+				},
+				Description:         "Map of names to generate, keyed by an arbitrary identifier.",
+				MarkdownDescription: "Map of names to generate, keyed by an arbitrary identifier. Each entry takes the resource type and the inputs that differ between names.",
+			},
+			"results": schema.MapAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				Description:         "The generated names, keyed like names.",
+				MarkdownDescription: "The generated names, keyed like `names`.",
+			},
+			"triggers": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+				Description:         "Map of values that should trigger new names to be generated when changed.",
+				MarkdownDescription: "Map of values that should trigger new names to be generated when changed. Common triggers include version numbers or Git commit hashes.",
+			},
+			"regenerate_on_change": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Whether to update names in place when their inputs no longer match them. Default: false",
+				MarkdownDescription: "Whether to update names in place when their inputs, such as `name`, `environment` or `location`, no longer generate the stored name. The random suffix of a stored name is kept. By default stored names are preserved and a warning shows the name the current inputs would generate.",
+			},
+			"desired_results": schema.MapAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				Description:         "The names the current configuration generates, keyed like names, which may differ from the stored results.",
+				MarkdownDescription: "The names the current configuration generates, keyed like `names`, which differ from the stored `results` when the inputs or naming conventions changed after the names were created. An element is null when the name cannot be determined, such as for a name with an unseeded random suffix that no longer matches the template.",
+			},
+			"drifted": schema.MapAttribute{
+				Computed:            true,
+				ElementType:         types.BoolType,
+				Description:         "Whether each stored result differs from its desired result, keyed like names.",
+				MarkdownDescription: "Whether each stored name in `results` differs from `desired_results`, keyed like `names`. Useful in `check` blocks to find naming convention drift without replacing names.",
+			},
+		},
+	}
+}
+
+func (r *AznameNamesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*AznameProviderModel)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AznameProviderModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.config = config
+}
+
+func (r *AznameNamesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var state AznameNamesResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.completeResults(ctx, &state, storedNames{})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *AznameNamesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// This is a no-op because the resource is computed.
+}
+
+func (r *AznameNamesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state AznameNamesResourceModel
+	var prior AznameNamesResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stored, diags := newStoredNames(ctx, prior.AznameNamesModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.completeResults(ctx, &state, stored)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *AznameNamesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// This is a no-op because the resource is computed.
+}
+
+func (r *AznameNamesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// If we're destroying, no need to compute anything
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan AznameNamesResourceModel
+	var state AznameNamesResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get current state (will be null for create operations)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// The ID follows name, which can be unknown until apply
+	plan.ID = types.StringUnknown()
+	if !plan.Name.IsUnknown() {
+		plan.ID = types.StringValue(plan.Name.ValueString())
+	}
+	if plan.Names.IsUnknown() {
+		plan.Results = types.MapUnknown(types.StringType)
+		plan.DesiredResults = types.MapUnknown(types.StringType)
+		plan.Drifted = types.MapUnknown(types.BoolType)
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+		return
+	}
+
+	entries, diags := namesEntries(ctx, plan.AznameNamesModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stored, diags := newStoredNames(ctx, state.AznameNamesModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Names already in state are preserved, like the result of azname_name,
+	// unless custom_name overrides them, regenerate_on_change applies or
+	// their resource type changed. New names are generated during planning
+	// so they're visible in terraform plan, unless they need an unseeded
+	// random suffix or their inputs are only known during apply.
+	fullyKnown := req.Config.Raw.IsFullyKnown()
+	regenerate := plan.RegenerateOnChange.ValueBool()
+	results := map[string]attr.Value{}
+	desired := map[string]attr.Value{}
+	for _, key := range slices.Sorted(maps.Keys(entries)) {
+		model := namesEntryModel(plan.AznameNamesModel, entries[key])
+
+		switch result, storedModel, ok := stored.get(key, entries[key]); {
+		case model.CustomName.IsUnknown():
+			results[key] = types.StringUnknown()
+			desired[key] = types.StringUnknown()
+			continue
+		case model.CustomName.IsNull() && ok && !fullyKnown:
+			// The desired name can only be determined during apply
+			results[key] = result
+			if regenerate {
+				results[key] = types.StringUnknown()
+			}
+			desired[key] = types.StringUnknown()
+			continue
+		case model.CustomName.IsNull() && ok:
+			var entryDiags diag.Diagnostics
+			results[key], desired[key], entryDiags = r.reconcileNamesEntry(ctx, model, result, storedModel, regenerate)
+			resp.Diagnostics.Append(namesEntryDiagnostics(key, entryDiags)...)
+			continue
+		case !fullyKnown:
+			results[key] = types.StringUnknown()
+			desired[key] = types.StringUnknown()
+			continue
+		}
+
+		if model.CustomName.IsNull() {
+			needsRandom, diags := NeedsRandomGeneration(ctx, model)
+			resp.Diagnostics.Append(namesEntryDiagnostics(key, diags)...)
+			if diags.HasError() {
+				continue
+			}
+			if needsRandom {
+				results[key] = types.StringUnknown()
+				desired[key] = types.StringUnknown()
+				continue
+			}
+		}
+
+		result, diags := generateNamesEntry(ctx, model, *r.config)
+		resp.Diagnostics.Append(namesEntryDiagnostics(key, diags)...)
+		results[key] = types.StringValue(result)
+		desired[key] = types.StringValue(result)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Results = types.MapValueMust(types.StringType, results)
+	setDesiredResults(&plan, desired)
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+}
+
+// completeResults generates the names and desired names that were unknown in
+// the plan. Names stored in state are kept, or regenerated with their random
+// suffix when regenerate_on_change is set.
+func (r *AznameNamesResource) completeResults(ctx context.Context, state *AznameNamesResourceModel, stored storedNames) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	state.ID = types.StringValue(state.Name.ValueString())
+	entries, entryDiags := namesEntries(ctx, state.AznameNamesModel)
+	diags.Append(entryDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	planned := map[string]attr.Value{}
+	if !state.Results.IsUnknown() {
+		planned = state.Results.Elements()
+	}
+	plannedDesired := map[string]attr.Value{}
+	if !state.DesiredResults.IsUnknown() && !state.DesiredResults.IsNull() {
+		plannedDesired = state.DesiredResults.Elements()
+	}
+
+	results := map[string]attr.Value{}
+	desired := map[string]attr.Value{}
+	for _, key := range slices.Sorted(maps.Keys(entries)) {
+		model := namesEntryModel(state.AznameNamesModel, entries[key])
+		storedResult, storedModel, kept := stored.get(key, entries[key])
+		kept = kept && model.CustomName.IsNull()
+
+		switch result, ok := planned[key]; {
+		case ok && !result.IsUnknown():
+			results[key] = result
+		case kept:
+			// The inputs were only known during apply, so the stored name
+			// is kept unless regenerate_on_change applies
+			results[key] = storedResult
+			if !state.RegenerateOnChange.ValueBool() {
+				break
+			}
+			name, nameOK, entryDiags := r.desiredNamesEntry(ctx, model, storedResult, storedModel)
+			diags.Append(namesEntryDiagnostics(key, entryDiags)...)
+			if nameOK {
+				results[key] = types.StringValue(name)
+				break
+			}
+			if !entryDiags.HasError() {
+				var warnings diag.Diagnostics
+				warnings.AddWarning(
+					"Name not regenerated",
+					fmt.Sprintf("The stored name %q has no random suffix that can be reused, so it is kept. Change triggers to generate a new name.", storedResult.ValueString()),
+				)
+				diags.Append(namesEntryDiagnostics(key, warnings)...)
+			}
+		default:
+			result, entryDiags := generateNamesEntry(ctx, model, *r.config)
+			diags.Append(namesEntryDiagnostics(key, entryDiags)...)
+			results[key] = types.StringValue(result)
+		}
+
+		// The desired names are informational and never fail the apply
+		switch plannedName, ok := plannedDesired[key]; {
+		case ok && !plannedName.IsUnknown():
+			desired[key] = plannedName
+		case kept:
+			name, nameOK, _ := r.desiredNamesEntry(ctx, model, storedResult, storedModel)
+			desired[key] = types.StringNull()
+			if nameOK {
+				desired[key] = types.StringValue(name)
+			}
+		default:
+			desired[key] = results[key]
+		}
+	}
+
+	state.Results = types.MapValueMust(types.StringType, results)
+	setDesiredResults(state, desired)
+	return diags
+}
+
+// storedNames holds the names of azname_names stored in state, with the
+// entries they were generated from.
+type storedNames struct {
+	shared  AznameNamesModel
+	entries map[string]AznameNamesEntryModel
+	results map[string]attr.Value
+}
+
+// newStoredNames returns the names stored in state, which has none when the
+// resource is being created.
+func newStoredNames(ctx context.Context, state AznameNamesModel) (storedNames, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if state.Names.IsNull() || state.Names.IsUnknown() || state.Results.IsNull() || state.Results.IsUnknown() {
+		return storedNames{}, diags
+	}
+
+	entries, diags := namesEntries(ctx, state)
+	return storedNames{shared: state, entries: entries, results: state.Results.Elements()}, diags
+}
+
+// get returns the stored name of an entry and the inputs it was generated
+// from. A stored name is only kept while the resource type of its entry
+// stays the same; otherwise the entry is treated as a new name.
+func (s storedNames) get(key string, entry AznameNamesEntryModel) (types.String, AznameNameModel, bool) {
+	result, ok := s.results[key].(types.String)
+	storedEntry, hasEntry := s.entries[key]
+	if !ok || !hasEntry || result.IsNull() || result.IsUnknown() || !sameResourceType(storedEntry.ResourceType, entry.ResourceType) {
+		return types.StringNull(), AznameNameModel{}, false
+	}
+	return result, namesEntryModel(s.shared, storedEntry), true
+}
+
+// sameResourceType reports whether two resource_type values name the same
+// resource type, such as "rg" and "azurerm_resource_group".
+func sameResourceType(a, b types.String) bool {
+	if a.IsUnknown() || b.IsUnknown() {
+		return false
+	}
+	if a.Equal(b) {
+		return true
+	}
+
+	definitionA, errA := resources.GetResourceDefinition(a.ValueString())
+	definitionB, errB := resources.GetResourceDefinition(b.ValueString())
+	return errA == nil && errB == nil && definitionA.ResourceTypeName == definitionB.ResourceTypeName
+}
+
+// desiredNamesEntry returns the name the current inputs of an entry generate,
+// reusing the random suffix parsed from its stored name. The name cannot be
+// known when an unseeded random suffix is needed but cannot be parsed from
+// the stored name, in which case ok is false.
+func (r *AznameNamesResource) desiredNamesEntry(ctx context.Context, model AznameNameModel, stored types.String, storedModel AznameNameModel) (result string, ok bool, diags diag.Diagnostics) {
+	// ModifyPlan reports the errors of custom_name on every plan
+	if !model.CustomName.IsNull() {
+		return model.CustomName.ValueString(), !checkCustomName(model).HasError(), diags
+	}
+
+	needsRandom, diags := NeedsRandomGeneration(ctx, model)
+	if diags.HasError() {
+		return "", false, diags
+	}
+
+	var randomSuffix string
+	if needsRandom {
+		parsed, parsedOK, _ := parseName(ctx, stored.ValueString(), storedModel, *r.config)
+		randomSuffix = parsed.values["rand"]
+		if !parsedOK || randomSuffix == "" {
+			return "", false, diags
+		}
+	}
+
+	result, _, diags = generateName(ctx, model, *r.config, randomSuffix)
+	return result, !diags.HasError(), diags
+}
+
+// reconcileNamesEntry compares the stored name of an entry with the name its
+// current inputs generate, like reconcileResult for azname_name. It returns
+// the planned name and the desired name, which is null when it cannot be
+// determined. With regenerate_on_change the planned name is the desired
+// name, otherwise a warning shows the difference.
+func (r *AznameNamesResource) reconcileNamesEntry(ctx context.Context, model AznameNameModel, stored types.String, storedModel AznameNameModel, regenerate bool) (types.String, types.String, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	desired, ok, generateDiags := r.desiredNamesEntry(ctx, model, stored, storedModel)
+	if !ok {
+		// generation errors only matter when the name is about to change
+		if regenerate {
+			diags.Append(generateDiags...)
+		}
+		return stored, types.StringNull(), diags
+	}
+	if desired == stored.ValueString() {
+		return stored, types.StringValue(desired), diags
+	}
+
+	if regenerate {
+		diags.Append(generateDiags...)
+		return types.StringValue(desired), types.StringValue(desired), diags
+	}
+
+	diags.AddWarning(
+		"Name inputs changed",
+		fmt.Sprintf("The stored name %q no longer matches the name %q generated from the current configuration. The stored name is kept; set regenerate_on_change to update it in place, or change triggers to replace it.", stored.ValueString(), desired),
+	)
+	return stored, types.StringValue(desired), diags
+}
+
+// setDesiredResults sets desired_results and drifted, comparing each desired
+// name with its result like setDesiredResult. drifted is null for entries
+// whose desired name is null, and unknown until both names are known.
+func setDesiredResults(model *AznameNamesResourceModel, desired map[string]attr.Value) {
+	results := model.Results.Elements()
+	drifted := map[string]attr.Value{}
+	for key, desiredName := range desired {
+		result := results[key]
+		switch {
+		case desiredName.IsNull():
+			drifted[key] = types.BoolNull()
+		case desiredName.IsUnknown() || result.IsUnknown():
+			drifted[key] = types.BoolUnknown()
+		default:
+			drifted[key] = types.BoolValue(!desiredName.Equal(result))
+		}
+	}
+
+	model.DesiredResults = types.MapValueMust(types.StringType, desired)
+	model.Drifted = types.MapValueMust(types.BoolType, drifted)
+}
+
+// namesEntries returns the entries of names, keyed like names.
+func namesEntries(ctx context.Context, model AznameNamesModel) (map[string]AznameNamesEntryModel, diag.Diagnostics) {
+	entries := map[string]AznameNamesEntryModel{}
+	diags := model.Names.ElementsAs(ctx, &entries, false)
+	return entries, diags
+}
+
+// namesEntryModel combines the shared inputs with the inputs of an entry into
+// the model of a single name. Entry values override the shared values and
+// entry components are merged over the shared components.
+func namesEntryModel(shared AznameNamesModel, entry AznameNamesEntryModel) AznameNameModel {
+	model := newNameModel(entry.ResourceType.ValueString())
+	model.ResourceType = entry.ResourceType
+	model.Name = shared.Name
+	model.Environment = shared.Environment
+	model.Prefixes = shared.Prefixes
+	model.Suffixes = shared.Suffixes
+	model.Separator = shared.Separator
+	model.RandomSeed = shared.RandomSeed
+	model.Location = shared.Location
+	model.TruncateStrategy = shared.TruncateStrategy
+	model.Components = shared.Components
+	model.RandomCharset = shared.RandomCharset
+	model.UniqueFrom = shared.UniqueFrom
+	model.Random = shared.Random
+
+	model.Service = entry.Service
+	model.Instance = entry.Instance
+	model.ParentName = entry.ParentName
+	model.TemplateName = entry.TemplateName
+	model.Template = entry.Template
+	model.CustomName = entry.CustomName
+	model.SkipValidation = entry.SkipValidation
+	if !entry.Location.IsNull() {
+		model.Location = entry.Location
+	}
+	if !entry.Random.IsNull() {
+		model.Random = entry.Random
+	}
+	if !entry.Components.IsNull() {
+		components := map[string]attr.Value{}
+		if !shared.Components.IsNull() {
+			maps.Copy(components, shared.Components.Elements())
+		}
+		maps.Copy(components, entry.Components.Elements())
+		model.Components = types.MapValueMust(types.StringType, components)
+	}
+
+	return model
+}
+
+// generateNamesEntry returns the custom_name of an entry, or generates its
+// name.
+func generateNamesEntry(ctx context.Context, model AznameNameModel, config AznameProviderModel) (string, diag.Diagnostics) {
+	if !model.CustomName.IsNull() {
+		return model.CustomName.ValueString(), checkCustomName(model)
+	}
+	return GenerateName(ctx, model, config)
+}
+
+// namesEntryDiagnostics moves the diagnostics of generating the name of an
+// entry to that entry, so each one shows which name it relates to.
+func namesEntryDiagnostics(key string, diags diag.Diagnostics) diag.Diagnostics {
+	var entryDiags diag.Diagnostics
+	entryPath := path.Root("names").AtMapKey(key)
+
+	for _, d := range diags {
+		detail := fmt.Sprintf("Name %q: %s", key, d.Detail())
+		if d.Severity() == diag.SeverityError {
+			entryDiags.AddAttributeError(entryPath, d.Summary(), detail)
+		} else {
+			entryDiags.AddAttributeWarning(entryPath, d.Summary(), detail)
+		}
+	}
+
+	return entryDiags
+}
@@ -0,0 +1,172 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestNamesResource(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+					resource "azname_names" "test" {
+						name        = "myapp"
+						environment = "dev"
+
+						names = {
+							rg = {
+								resource_type = "azurerm_resource_group"
+								service       = "web"
+							}
+							kv = {
+								resource_type = "azurerm_key_vault"
+							}
+						}
+					}
+					`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						// Names without an unseeded random suffix are known at plan time
+						plancheck.ExpectKnownValue(
+							"azname_names.test",
+							tfjsonpath.New("results").AtMapKey("rg"),
+							knownvalue.StringExact("azname-rg-myapp-dev-web"),
+						),
+						plancheck.ExpectUnknownValue(
+							"azname_names.test",
+							tfjsonpath.New("results").AtMapKey("kv"),
+						),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("azname_names.test", "results.rg", "azname-rg-myapp-dev-web"),
+					resource.TestMatchResourceAttr("azname_names.test", "results.kv", regexp.MustCompile(`^azname-kv-myapp-dev-\d{3}$`)),
+				),
+			},
+			// Stored names are kept when the inputs change, new keys are added
+			{
+				Config: providerConfig + `
+					resource "azname_names" "test" {
+						name        = "myapp"
+						environment = "prod"
+
+						names = {
+							rg = {
+								resource_type = "azurerm_resource_group"
+								service       = "web"
+							}
+							kv = {
+								resource_type = "azurerm_key_vault"
+							}
+							vnet = {
+								resource_type = "azurerm_virtual_network"
+							}
+						}
+					}
+					`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("azname_names.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("azname_names.test", "results.%", "3"),
+					resource.TestCheckResourceAttr("azname_names.test", "results.rg", "azname-rg-myapp-dev-web"),
+					resource.TestCheckResourceAttr("azname_names.test", "results.vnet", "azname-vnet-myapp-prod"),
+					// The drift of stored names is reported, reusing their random suffix
+					resource.TestCheckResourceAttr("azname_names.test", "desired_results.rg", "azname-rg-myapp-prod-web"),
+					resource.TestMatchResourceAttr("azname_names.test", "desired_results.kv", regexp.MustCompile(`^azname-kv-myapp-prod-\d{3}$`)),
+					resource.TestCheckResourceAttr("azname_names.test", "drifted.rg", "true"),
+					resource.TestCheckResourceAttr("azname_names.test", "drifted.kv", "true"),
+					resource.TestCheckResourceAttr("azname_names.test", "drifted.vnet", "false"),
+				),
+			},
+			// A changed resource type generates a new name for its key
+			{
+				Config: providerConfig + `
+					resource "azname_names" "test" {
+						name        = "myapp"
+						environment = "prod"
+
+						names = {
+							rg = {
+								resource_type = "azurerm_network_security_group"
+								service       = "web"
+							}
+							kv = {
+								resource_type = "kv"
+							}
+							vnet = {
+								resource_type = "azurerm_virtual_network"
+							}
+						}
+					}
+					`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectKnownValue(
+							"azname_names.test",
+							tfjsonpath.New("results").AtMapKey("rg"),
+							knownvalue.StringExact("azname-nsg-myapp-prod-web"),
+						),
+						// Another name for the same resource type keeps the stored name
+						plancheck.ExpectKnownValue(
+							"azname_names.test",
+							tfjsonpath.New("results").AtMapKey("kv"),
+							knownvalue.StringRegexp(regexp.MustCompile(`^azname-kv-myapp-dev-\d{3}$`)),
+						),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("azname_names.test", "drifted.rg", "false"),
+					resource.TestCheckResourceAttr("azname_names.test", "drifted.kv", "true"),
+				),
+			},
+			// regenerate_on_change updates drifted names in place
+			{
+				Config: providerConfig + `
+					resource "azname_names" "test" {
+						name                 = "myapp"
+						environment          = "prod"
+						regenerate_on_change = true
+
+						names = {
+							rg = {
+								resource_type = "azurerm_network_security_group"
+								service       = "web"
+							}
+							kv = {
+								resource_type = "kv"
+							}
+							vnet = {
+								resource_type = "azurerm_virtual_network"
+							}
+						}
+					}
+					`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("azname_names.test", plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue(
+							"azname_names.test",
+							tfjsonpath.New("results").AtMapKey("kv"),
+							knownvalue.StringRegexp(regexp.MustCompile(`^azname-kv-myapp-prod-\d{3}$`)),
+						),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("azname_names.test", "results.kv", "azname_names.test", "desired_results.kv"),
+					resource.TestCheckResourceAttr("azname_names.test", "drifted.kv", "false"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"terraform-provider-azname/internal/nametemplate"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ function.Function = ParseNameFunction{}
+)
+
+// parsedNameTokens are the built-in tokens returned by parse_name.
+var parsedNameTokens = []string{"prefix", "resource_type", "workload", "environment", "service", "location", "instance", "rand", "suffix", "parent_name"}
+
+// parsedNameAttributeTypes is the object type returned by parse_name.
+var parsedNameAttributeTypes = func() map[string]attr.Type {
+	attributeTypes := map[string]attr.Type{
+		"components": types.MapType{ElemType: types.StringType},
+	}
+	for _, token := range parsedNameTokens {
+		attributeTypes[token] = types.StringType
+	}
+	return attributeTypes
+}()
+
+func NewParseNameFunction() function.Function {
+	return ParseNameFunction{}
+}
+
+type ParseNameFunction struct{}
+
+func (r ParseNameFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_name"
+}
+
+func (r ParseNameFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parse a generated name",
+		MarkdownDescription: `Splits an existing name back into its components, decoded according to the template and separator for the resource type.
+Returns an object with the attributes ` + "`prefix`, `resource_type`, `workload`, `environment`, `service`, `location`, `instance`, `rand`, `suffix` and `parent_name`" + `, which are null when the token is not part of the name, and a ` + "`components`" + ` map with the values of custom tokens. The location is returned as it appears in the name, typically the region short name.
+
+Terraform calls provider functions without the provider configuration, so templates and defaults are read from the ` + "`AZNAME_*`" + ` environment variables. Fails if the name does not match the template.`,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "name",
+				MarkdownDescription: "The name to parse, e.g. 'rg-myapp-prod-wus2001'",
+			},
+			function.StringParameter{
+				Name:                "resource_type",
+				MarkdownDescription: "Resource type of the name, e.g. 'azurerm_resource_group', 'rg' or 'Microsoft.Resources/resourceGroups'",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: parsedNameAttributeTypes,
+		},
+	}
+}
+
+func (r ParseNameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name, resourceType string
+
+	resp.Error = req.Arguments.Get(ctx, &name, &resourceType)
+	if resp.Error != nil {
+		return
+	}
+
+	config, diags := providerDefaults(ctx)
+	if diags.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+
+	parsed, ok, diags := parseName(ctx, name, newNameModel(resourceType), config)
+	if diags.HasError() {
+		resp.Error = funcErrorFromParseDiags(diags)
+		return
+	}
+	if !ok {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("name %q does not match the template for %s", name, resourceType))
+		return
+	}
+
+	attributes := map[string]attr.Value{}
+	for _, token := range parsedNameTokens {
+		attributes[token] = types.StringNull()
+		if value := parsed.values[token]; value != "" {
+			attributes[token] = types.StringValue(value)
+		}
+	}
+
+	components := map[string]attr.Value{}
+	for token, value := range parsed.values {
+		if !slices.Contains(nametemplate.BuiltinTokens, token) && value != "" {
+			components[token] = types.StringValue(value)
+		}
+	}
+	attributes["components"] = types.MapValueMust(types.StringType, components)
+
+	result, diags := types.ObjectValue(parsedNameAttributeTypes, attributes)
+	if diags.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// funcErrorFromParseDiags converts the errors of parsing a name to function
+// errors. Unknown resource types are reported on the resource_type argument,
+// other errors come from the provider configuration.
+func funcErrorFromParseDiags(diags diag.Diagnostics) *function.FuncError {
+	var funcErr *function.FuncError
+	for _, d := range diags.Errors() {
+		if d, ok := d.(diag.DiagnosticWithPath); ok && d.Path().Equal(path.Root("resource_type")) {
+			funcErr = function.ConcatFuncErrors(funcErr, function.NewArgumentFuncError(1, d.Detail()))
+			continue
+		}
+		funcErr = function.ConcatFuncErrors(funcErr, function.NewFuncError(d.Detail()))
+	}
+	return funcErr
+}
@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestParseNameFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				locals {
+					parsed = provider::azname::parse_name("rg-myapp-dev-wus2001", "azurerm_resource_group")
+				}
+				output "workload" {
+					value = local.parsed.workload
+				}
+				output "environment" {
+					value = local.parsed.environment
+				}
+				output "location" {
+					value = local.parsed.location
+				}
+				output "instance" {
+					value = local.parsed.instance
+				}
+				output "service" {
+					value = coalesce(local.parsed.service, "none")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("workload", "myapp"),
+					resource.TestCheckOutput("environment", "dev"),
+					resource.TestCheckOutput("location", "wus2"),
+					resource.TestCheckOutput("instance", "001"),
+					resource.TestCheckOutput("service", "none"),
+				),
+			},
+		},
+	})
+}
+
+func TestParseNameFunction_NoMatch(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::azname::parse_name("legacy_rg_01", "azurerm_resource_group")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)"name"\s+parameter.*does not match the template`),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::azname::parse_name("rg-myapp", "azurerm_resource_grup")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)"resource_type"\s+parameter.*unknown resource type`),
+			},
+		},
+	})
+}
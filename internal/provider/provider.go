@@ -3,15 +3,20 @@ package provider
 import (
 	"context"
 	"fmt"
+	"maps"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -19,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
+	"terraform-provider-azname/internal/nametemplate"
 	"terraform-provider-azname/internal/overrides"
 	"terraform-provider-azname/internal/regions"
 	"terraform-provider-azname/internal/resources"
@@ -49,17 +55,24 @@ type AznameProvider struct {
 
 // AznameProviderModel maps provider schema data to a Go type.
 type AznameProviderModel struct {
-	Template       types.String `tfsdk:"template"`
-	TemplateChild  types.String `tfsdk:"template_child"`
-	Separator      types.String `tfsdk:"separator"`
-	Prefixes       types.List   `tfsdk:"prefixes"`
-	Suffixes       types.List   `tfsdk:"suffixes"`
-	CleanOutput    types.Bool   `tfsdk:"clean_output"`
-	TrimOutput     types.Bool   `tfsdk:"trim_output"`
-	RandomLength   types.Int64  `tfsdk:"random_length"`
-	InstanceLength types.Int64  `tfsdk:"instance_length"`
-	Environment    types.String `tfsdk:"environment"`
-	Location       types.String `tfsdk:"location"`
+	Template         types.String `tfsdk:"template"`
+	TemplateChild    types.String `tfsdk:"template_child"`
+	Templates        types.Map    `tfsdk:"templates"`
+	Components       types.Map    `tfsdk:"components"`
+	Separator        types.String `tfsdk:"separator"`
+	Prefixes         types.List   `tfsdk:"prefixes"`
+	Suffixes         types.List   `tfsdk:"suffixes"`
+	CleanOutput      types.Bool   `tfsdk:"clean_output"`
+	TrimOutput       types.Bool   `tfsdk:"trim_output"`
+	RandomLength     types.Int64  `tfsdk:"random_length"`
+	InstanceLength   types.Int64  `tfsdk:"instance_length"`
+	Environment      types.String `tfsdk:"environment"`
+	Location         types.String `tfsdk:"location"`
+	Case             types.String `tfsdk:"case"`
+	PadStrategy      types.String `tfsdk:"pad_strategy"`
+	TruncatePriority types.List   `tfsdk:"truncate_priority"`
+	TruncateStrategy types.String `tfsdk:"truncate_strategy"`
+	RandomCharset    types.String `tfsdk:"random_charset"`
 }
 
 // Metadata returns the provider type name.
@@ -84,6 +97,18 @@ func (p *AznameProvider) Schema(_ context.Context, _ provider.SchemaRequest, res
 				Description:         "Template for child resource name generation. Default: {parent_name}~{resource_type}{instance}~{rand}",
 				MarkdownDescription: "Template for child resource name generation. Uses ~ as a placeholder for the separator character. Can be set via `AZNAME_TEMPLATE_CHILD` environment variable.",
 			},
+			"templates": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				Description:         "Map of named templates that resources and data sources can select with template_name.",
+				MarkdownDescription: "Map of named templates that resources and data sources can select with `template_name`, for example to use different layouts for networking, data and application resources. Uses ~ as a placeholder for the separator character.",
+			},
+			"components": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				Description:         "Map of default values for custom template tokens.",
+				MarkdownDescription: "Map of default values for custom template tokens. A key of `team` provides the value for a `{team}` token. Can be overridden per key at resource/data source level. Keys cannot reuse the names of built-in tokens.",
+			},
 			"separator": schema.StringAttribute{
 				Optional:            true,
 				Description:         "Character to use as separator in resource names. Default: -",
@@ -140,6 +165,47 @@ func (p *AznameProvider) Schema(_ context.Context, _ provider.SchemaRequest, res
 				Description:         "Default location for all resources. Default: empty",
 				MarkdownDescription: "Default location (e.g., eastus, westeurope) to use in resource names. Can be overridden at resource/data source level. Can be set via `AZNAME_LOCATION` environment variable.",
 			},
+			"case": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Case normalization applied to generated names when the resource type does not require lowercase. Default: preserve",
+				MarkdownDescription: "Case normalization applied to generated names: `preserve`, `lower` or `upper`. Resource types that require lowercase names are always lowercased regardless of this setting. Can be set via `AZNAME_CASE` environment variable.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("preserve", "lower", "upper"),
+				},
+			},
+			"pad_strategy": schema.StringAttribute{
+				Optional:            true,
+				Description:         "How to handle generated names shorter than the resource type minimum length. Default: none",
+				MarkdownDescription: "How to handle generated names shorter than the resource type minimum length: `none` reports an error, `random` extends the random segment with extra digits and `filler` pads with zeros. Padding is inserted where `{rand}` appears in the template, or appended to the name otherwise. Can be set via `AZNAME_PAD_STRATEGY` environment variable.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("none", "random", "filler"),
+				},
+			},
+			"truncate_priority": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				Description:         "Template tokens to shorten, in order, when a generated name exceeds the maximum length. Default: [workload, service]",
+				MarkdownDescription: "Template tokens (without braces) to shorten, in order, when a generated name exceeds the resource type maximum length. `rand`, `instance` and `location` are always kept intact. If shortening these tokens is not enough, the name is cut at the maximum length. Can be set via `AZNAME_TRUNCATE_PRIORITY` environment variable (comma-separated).",
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.NoneOf("rand", "instance", "location")),
+				},
+			},
+			"truncate_strategy": schema.StringAttribute{
+				Optional:            true,
+				Description:         "How to shorten generated names that exceed the maximum length. Default: segment",
+				MarkdownDescription: "How to shorten generated names that exceed the resource type maximum length: `segment` shortens the tokens listed in `truncate_priority`, `hash` cuts the name and appends a short hash of the full name so distinct inputs stay distinct. Can be overridden at resource/data source level. Can be set via `AZNAME_TRUNCATE_STRATEGY` environment variable.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("segment", "hash"),
+				},
+			},
+			"random_charset": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Characters to draw the random suffix from. Default: numeric",
+				MarkdownDescription: "Characters to draw the `{rand}` suffix from: `numeric` (digits), `hex` (lowercase hexadecimal), `alnum` (lowercase letters and digits) or `crockford` (Crockford's base32, uppercase unless the resource type requires lowercase names). Can be overridden at resource/data source level. Can be set via `AZNAME_RANDOM_CHARSET` environment variable.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(randomCharsetNames...),
+				},
+			},
 		},
 	}
 }
@@ -152,6 +218,21 @@ func (p *AznameProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		return
 	}
 
+	resp.Diagnostics.Append(configureProvider(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.ResourceData = &config
+	resp.DataSourceData = &config
+}
+
+// configureProvider fills in the settings missing from config from
+// environment variables and defaults, validates the templates and applies
+// the overrides file.
+func configureProvider(ctx context.Context, config *AznameProviderModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
 	template, ok := os.LookupEnv("AZNAME_TEMPLATE")
 	if !ok {
 		template = "{prefix}~{resource_type}~{workload}~{environment}~{service}~{location}{instance}{rand}~{suffix}"
@@ -190,6 +271,26 @@ func (p *AznameProvider) Configure(ctx context.Context, req provider.ConfigureRe
 	if !ok {
 		location = ""
 	}
+	nameCase, ok := os.LookupEnv("AZNAME_CASE")
+	if !ok {
+		nameCase = "preserve"
+	}
+	pad_strategy, ok := os.LookupEnv("AZNAME_PAD_STRATEGY")
+	if !ok {
+		pad_strategy = "none"
+	}
+	truncate_priority, ok := os.LookupEnv("AZNAME_TRUNCATE_PRIORITY")
+	if !ok {
+		truncate_priority = "workload,service"
+	}
+	truncate_strategy, ok := os.LookupEnv("AZNAME_TRUNCATE_STRATEGY")
+	if !ok {
+		truncate_strategy = "segment"
+	}
+	random_charset, ok := os.LookupEnv("AZNAME_RANDOM_CHARSET")
+	if !ok {
+		random_charset = "numeric"
+	}
 
 	// Check for required attributes, and set defaults.
 	if config.Template.IsNull() {
@@ -198,6 +299,12 @@ func (p *AznameProvider) Configure(ctx context.Context, req provider.ConfigureRe
 	if config.TemplateChild.IsNull() {
 		config.TemplateChild = types.StringValue(template_child)
 	}
+	if config.Templates.IsNull() {
+		config.Templates = types.MapValueMust(types.StringType, map[string]attr.Value{})
+	}
+	if config.Components.IsNull() {
+		config.Components = types.MapValueMust(types.StringType, map[string]attr.Value{})
+	}
 	if config.Separator.IsNull() {
 		config.Separator = types.StringValue(separator)
 	}
@@ -207,8 +314,9 @@ func (p *AznameProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		for _, prefix := range prefixList {
 			attrPrefixes = append(attrPrefixes, types.StringValue(prefix))
 		}
-		config.Prefixes, diags = types.ListValue(types.StringType, attrPrefixes)
-		resp.Diagnostics.Append(diags...)
+		var listDiags diag.Diagnostics
+		config.Prefixes, listDiags = types.ListValue(types.StringType, attrPrefixes)
+		diags.Append(listDiags...)
 	}
 	if config.Suffixes.IsNull() {
 		suffixList := strings.Split(suffixes, ",")
@@ -216,8 +324,9 @@ func (p *AznameProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		for _, suffix := range suffixList {
 			attrSuffixes = append(attrSuffixes, types.StringValue(suffix))
 		}
-		config.Suffixes, diags = types.ListValue(types.StringType, attrSuffixes)
-		resp.Diagnostics.Append(diags...)
+		var listDiags diag.Diagnostics
+		config.Suffixes, listDiags = types.ListValue(types.StringType, attrSuffixes)
+		diags.Append(listDiags...)
 	}
 	if config.CleanOutput.IsNull() {
 		config.CleanOutput = types.BoolValue(clean_output == "1")
@@ -228,14 +337,14 @@ func (p *AznameProvider) Configure(ctx context.Context, req provider.ConfigureRe
 	if config.RandomLength.IsNull() {
 		randomLength, err := strconv.ParseInt(random_length, 10, 64)
 		if err != nil || randomLength < 1 || randomLength > 6 {
-			resp.Diagnostics.AddError("Invalid value for AZNAME_RANDOM_LENGTH", "The value must be a number between 1 and 6")
+			diags.AddError("Invalid value for AZNAME_RANDOM_LENGTH", "The value must be a number between 1 and 6")
 		}
 		config.RandomLength = types.Int64Value(randomLength)
 	}
 	if config.InstanceLength.IsNull() {
 		instanceLength, err := strconv.ParseInt(instance_length, 10, 64)
 		if err != nil || instanceLength < 1 || instanceLength > 6 {
-			resp.Diagnostics.AddError("Invalid value for AZNAME_INSTANCE_LENGTH", "The value must be a number between 1 and 6")
+			diags.AddError("Invalid value for AZNAME_INSTANCE_LENGTH", "The value must be a number between 1 and 6")
 		}
 		config.InstanceLength = types.Int64Value(instanceLength)
 	}
@@ -245,16 +354,93 @@ func (p *AznameProvider) Configure(ctx context.Context, req provider.ConfigureRe
 	if config.Location.IsNull() {
 		config.Location = types.StringValue(location)
 	}
+	if config.Case.IsNull() {
+		if nameCase != "preserve" && nameCase != "lower" && nameCase != "upper" {
+			diags.AddError("Invalid value for AZNAME_CASE", "The value must be one of preserve, lower or upper")
+		}
+		config.Case = types.StringValue(nameCase)
+	}
+	if config.PadStrategy.IsNull() {
+		if pad_strategy != "none" && pad_strategy != "random" && pad_strategy != "filler" {
+			diags.AddError("Invalid value for AZNAME_PAD_STRATEGY", "The value must be one of none, random or filler")
+		}
+		config.PadStrategy = types.StringValue(pad_strategy)
+	}
+	if config.TruncatePriority.IsNull() {
+		var attrPriority []attr.Value
+		for _, token := range strings.Split(truncate_priority, ",") {
+			attrPriority = append(attrPriority, types.StringValue(strings.TrimSpace(token)))
+		}
+		var listDiags diag.Diagnostics
+		config.TruncatePriority, listDiags = types.ListValue(types.StringType, attrPriority)
+		diags.Append(listDiags...)
+	}
+	if config.TruncateStrategy.IsNull() {
+		if truncate_strategy != "segment" && truncate_strategy != "hash" {
+			diags.AddError("Invalid value for AZNAME_TRUNCATE_STRATEGY", "The value must be one of segment or hash")
+		}
+		config.TruncateStrategy = types.StringValue(truncate_strategy)
+	}
+	if config.RandomCharset.IsNull() {
+		if !slices.Contains(randomCharsetNames, random_charset) {
+			diags.AddError("Invalid value for AZNAME_RANDOM_CHARSET", "The value must be one of "+strings.Join(randomCharsetNames, ", "))
+		}
+		config.RandomCharset = types.StringValue(random_charset)
+	}
 
-	if resp.Diagnostics.HasError() {
-		return
+	if diags.HasError() {
+		return diags
 	}
 
-	// Load and apply overrides from azname_overrides.yaml
+	// Validate templates up front so mistakes surface at configure time rather
+	// than as stray braces in generated names
+	var components map[string]string
+	diags.Append(config.Components.ElementsAs(ctx, &components, false)...)
+	if diags.HasError() {
+		return diags
+	}
+	componentNames := slices.Collect(maps.Keys(components))
+
+	for token := range components {
+		if slices.Contains(nametemplate.BuiltinTokens, token) {
+			diags.AddAttributeError(path.Root("components").AtMapKey(token), "Invalid component", fmt.Sprintf("Component %q conflicts with the built-in {%s} token.", token, token))
+		}
+	}
+	if err := nametemplate.Validate(config.Template.ValueString(), componentNames); err != nil {
+		diags.AddAttributeError(path.Root("template"), "Invalid template", err.Error())
+	}
+	if err := nametemplate.Validate(config.TemplateChild.ValueString(), componentNames); err != nil {
+		diags.AddAttributeError(path.Root("template_child"), "Invalid template", err.Error())
+	}
+	for name, value := range config.Templates.Elements() {
+		template, ok := value.(types.String)
+		if !ok {
+			continue
+		}
+		if err := nametemplate.Validate(template.ValueString(), componentNames); err != nil {
+			diags.AddAttributeError(path.Root("templates").AtMapKey(name), "Invalid template", err.Error())
+		}
+	}
+
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(loadOverrides(ctx, componentNames)...)
+
+	return diags
+}
+
+// loadOverrides loads and applies overrides from azname_overrides.yaml. Its
+// template overrides are validated like the provider templates, against the
+// given component names.
+func loadOverrides(ctx context.Context, componentNames []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
 	ovr, err := overrides.DiscoverAndLoadOverrides()
 	if err != nil {
 		// Only warn if file exists but is invalid
-		resp.Diagnostics.AddWarning(
+		diags.AddWarning(
 			"Override loading failed",
 			fmt.Sprintf("Failed to load overrides from azname_overrides.yaml: %s. Continuing without overrides.", err.Error()),
 		)
@@ -264,16 +450,54 @@ func (p *AznameProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		tflog.Info(ctx, "Applying overrides from azname_overrides.yaml")
 		regions.ApplyOverrides(ctx, ovr)
 		resources.ApplyOverrides(ctx, ovr)
+
+		for _, resourceType := range slices.Sorted(maps.Keys(ovr.ResourceTemplateOverrides)) {
+			if err := nametemplate.Validate(ovr.ResourceTemplateOverrides[resourceType], componentNames); err != nil {
+				diags.AddError(
+					"Invalid template override",
+					fmt.Sprintf("resource_template_overrides[%s] in azname_overrides.yaml: %s", resourceType, err.Error()),
+				)
+			}
+		}
 	}
 
-	resp.ResourceData = &config
-	resp.DataSourceData = &config
+	return diags
+}
+
+// providerDefaults returns the provider configuration used by provider
+// functions. Terraform calls functions without configuring the provider, so
+// only environment variables and defaults apply.
+func providerDefaults(ctx context.Context) (AznameProviderModel, diag.Diagnostics) {
+	config := AznameProviderModel{
+		Template:         types.StringNull(),
+		TemplateChild:    types.StringNull(),
+		Templates:        types.MapNull(types.StringType),
+		Components:       types.MapNull(types.StringType),
+		Separator:        types.StringNull(),
+		Prefixes:         types.ListNull(types.StringType),
+		Suffixes:         types.ListNull(types.StringType),
+		CleanOutput:      types.BoolNull(),
+		TrimOutput:       types.BoolNull(),
+		RandomLength:     types.Int64Null(),
+		InstanceLength:   types.Int64Null(),
+		Environment:      types.StringNull(),
+		Location:         types.StringNull(),
+		Case:             types.StringNull(),
+		PadStrategy:      types.StringNull(),
+		TruncatePriority: types.ListNull(types.StringType),
+		TruncateStrategy: types.StringNull(),
+		RandomCharset:    types.StringNull(),
+	}
+	diags := configureProvider(ctx, &config)
+	return config, diags
 }
 
 // DataSources defines the data sources implemented in the provider.
 func (p *AznameProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewAzNameDataSource,
+		NewAznameNamesDataSource,
+		NewAznameContextDataSource,
 	}
 }
 
@@ -281,6 +505,7 @@ func (p *AznameProvider) DataSources(_ context.Context) []func() datasource.Data
 func (p *AznameProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewAznameResource,
+		NewAznameNamesResource,
 	}
 }
 
@@ -289,5 +514,8 @@ func (p *AznameProvider) Functions(_ context.Context) []func() function.Function
 		NewCliNameFunction,
 		NewFullNameFunction,
 		NewShortNameFunction,
+		NewParseNameFunction,
+		NewValidateNameFunction,
+		NewGenerateNameFunction,
 	}
 }
@@ -1,6 +1,8 @@
 package provider
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
@@ -27,3 +29,24 @@ func testAccPreCheck(t *testing.T) {
 	// about the appropriate environment variables being set are common to see in a pre-check
 	// function.
 }
+
+// testAccOverrides runs the test in a directory with an azname_overrides.yaml
+// that defines the azname_test_widget resource type. Overrides are applied
+// once per process, so every test that needs them shares this file.
+func testAccOverrides(t *testing.T) {
+	dir := t.TempDir()
+	overrides := `
+new_resources:
+  azname_test_widget:
+    slug: wdg
+    min_length: 3
+    max_length: 20
+    scope: resourceGroup
+    dashes: true
+    lowercase: true
+`
+	if err := os.WriteFile(filepath.Join(dir, "azname_overrides.yaml"), []byte(overrides), 0o600); err != nil {
+		t.Fatalf("Failed to write overrides: %v", err)
+	}
+	t.Chdir(dir)
+}
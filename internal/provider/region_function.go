@@ -2,7 +2,6 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"terraform-provider-azname/internal/regions"
 
@@ -96,7 +95,7 @@ func (r CliNameFunction) Run(ctx context.Context, req function.RunRequest, resp
 
 	region, err := regions.GetRegionByAnyName(inputRegion)
 	if err != nil {
-		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("region not found: %s", inputRegion))
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
 		return
 	}
 
@@ -113,7 +112,7 @@ func (r FullNameFunction) Run(ctx context.Context, req function.RunRequest, resp
 
 	region, err := regions.GetRegionByAnyName(inputRegion)
 	if err != nil {
-		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("region not found: %s", inputRegion))
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
 		return
 	}
 
@@ -130,7 +129,7 @@ func (r ShortNameFunction) Run(ctx context.Context, req function.RunRequest, res
 
 	region, err := regions.GetRegionByAnyName(inputRegion)
 	if err != nil {
-		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("region not found: %s", inputRegion))
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
 		return
 	}
 
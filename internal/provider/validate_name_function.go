@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"context"
+
+	"terraform-provider-azname/internal/resources"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ function.Function = ValidateNameFunction{}
+)
+
+// validatedNameAttributeTypes is the object type returned by validate_name.
+var validatedNameAttributeTypes = map[string]attr.Type{
+	"valid":      types.BoolType,
+	"violations": types.ListType{ElemType: types.StringType},
+}
+
+func NewValidateNameFunction() function.Function {
+	return ValidateNameFunction{}
+}
+
+type ValidateNameFunction struct{}
+
+func (r ValidateNameFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "validate_name"
+}
+
+func (r ValidateNameFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Validate a name against the resource type rules",
+		MarkdownDescription: `Checks a name against the naming rules of a resource type: minimum and maximum length, lowercase, dashes, allowed characters and the validation pattern.
+Returns an object with a ` + "`valid`" + ` boolean and a ` + "`violations`" + ` list describing each rule the name breaks. Useful to assert that hand-picked names, such as ` + "`custom_name`" + `, comply in ` + "`precondition`" + ` blocks.`,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "name",
+				MarkdownDescription: "The name to validate, e.g. 'stmyappprod001'",
+			},
+			function.StringParameter{
+				Name:                "resource_type",
+				MarkdownDescription: "Resource type of the name, e.g. 'azurerm_storage_account', 'st' or 'Microsoft.Storage/storageAccounts'",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: validatedNameAttributeTypes,
+		},
+	}
+}
+
+func (r ValidateNameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name, resourceTypeName string
+
+	resp.Error = req.Arguments.Get(ctx, &name, &resourceTypeName)
+	if resp.Error != nil {
+		return
+	}
+
+	// overrides can add resource types or change their rules. Functions have
+	// no provider configuration, so template overrides are checked without
+	// custom components.
+	if diags := loadOverrides(ctx, nil); diags.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+
+	resourceType, err := resources.GetResourceDefinition(resourceTypeName)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	violations := []attr.Value{}
+	for _, violation := range validateName(name, resourceType) {
+		violations = append(violations, types.StringValue(violation))
+	}
+
+	result, diags := types.ObjectValue(validatedNameAttributeTypes, map[string]attr.Value{
+		"valid":      types.BoolValue(len(violations) == 0),
+		"violations": types.ListValueMust(types.StringType, violations),
+	})
+	if diags.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestValidateNameFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				locals {
+					valid   = provider::azname::validate_name("stmyappprod001", "azurerm_storage_account")
+					invalid = provider::azname::validate_name("St-MyApp", "azurerm_storage_account")
+				}
+				output "valid" {
+					value = local.valid.valid
+				}
+				output "invalid" {
+					value = local.invalid.valid
+				}
+				output "violations" {
+					value = join("; ", local.invalid.violations)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("valid", "true"),
+					resource.TestCheckOutput("invalid", "false"),
+					resource.TestMatchOutput("violations", regexp.MustCompile(`requires lowercase names; azurerm_storage_account does not allow dashes`)),
+				),
+			},
+		},
+	})
+}
+
+func TestValidateNameFunction_UnknownResourceType(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::azname::validate_name("myname", "azurerm_storage_acount")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`unknown resource type`),
+			},
+		},
+	})
+}
+
+func TestValidateNameFunction_OverrideResourceType(t *testing.T) {
+	testAccOverrides(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Resource types from the overrides file have no character rules
+			{
+				Config: `
+				locals {
+					valid   = provider::azname::validate_name("wdg-myapp-prod", "azname_test_widget")
+					invalid = provider::azname::validate_name("WDG-MYAPP-PROD", "azname_test_widget")
+				}
+				output "valid" {
+					value = local.valid.valid
+				}
+				output "violations" {
+					value = join("; ", local.invalid.violations)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("valid", "true"),
+					resource.TestCheckOutput("violations", "azname_test_widget requires lowercase names"),
+				),
+			},
+		},
+	})
+}
@@ -3,10 +3,12 @@ package regions
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strings"
 	"sync"
 
 	"terraform-provider-azname/internal/overrides"
+	"terraform-provider-azname/internal/suggest"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -174,6 +176,8 @@ func GetRegionByFullName(fullName string) (*region, error) {
 	return nil, errors.New("region not found")
 }
 
+// GetRegionByAnyName returns a region by its short, CLI or full name. If no
+// region matches, the error suggests the closest region names.
 func GetRegionByAnyName(name string) (*region, error) {
 	r, err := GetRegionByShortName(name)
 	if err == nil {
@@ -183,5 +187,28 @@ func GetRegionByAnyName(name string) (*region, error) {
 	if err == nil {
 		return r, nil
 	}
-	return GetRegionByFullName(name)
+	r, err = GetRegionByFullName(name)
+	if err == nil {
+		return r, nil
+	}
+	return nil, fmt.Errorf("region not found: %s%s", name, suggest.DidYouMean(suggestRegions(name)))
+}
+
+// ShortNames returns the short names of all regions, including overrides.
+func ShortNames() []string {
+	shortNames := make([]string, 0, len(regionsList))
+	for _, r := range regionsList {
+		shortNames = append(shortNames, r.ShortName)
+	}
+	return shortNames
+}
+
+// suggestRegions returns the CLI names of the regions closest to an unknown
+// region name, matching on any of the region's names.
+func suggestRegions(name string) []string {
+	candidates := make(map[string][]string, len(regionsList))
+	for _, r := range regionsList {
+		candidates[r.CliName] = []string{r.CliName, r.FullName, r.ShortName}
+	}
+	return suggest.Closest(name, candidates, 3)
 }
@@ -1,6 +1,8 @@
 package regions
 
 import (
+	"slices"
+	"strings"
 	"testing"
 )
 
@@ -48,3 +50,31 @@ func TestGetRegionByAnyName(t *testing.T) {
 		t.Errorf("Expected nil region, got %v", region)
 	}
 }
+
+func TestGetRegionByAnyNameSuggestions(t *testing.T) {
+	_, err := GetRegionByAnyName("Austrlia East")
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+	if !strings.Contains(err.Error(), "did you mean australiaeast") {
+		t.Errorf("Expected suggestion for australiaeast, got %v", err)
+	}
+
+	_, err = GetRegionByAnyName("invalid")
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+	if strings.Contains(err.Error(), "did you mean") {
+		t.Errorf("Expected no suggestions, got %v", err)
+	}
+}
+
+func TestShortNames(t *testing.T) {
+	shortNames := ShortNames()
+	if len(shortNames) != len(regionsList) {
+		t.Errorf("Expected %d short names, got %d", len(regionsList), len(shortNames))
+	}
+	if !slices.Contains(shortNames, "ae") {
+		t.Errorf("Expected short names to contain ae, got %v", shortNames)
+	}
+}
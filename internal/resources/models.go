@@ -6,20 +6,67 @@ package resources
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 	"sync"
 
 	"terraform-provider-azname/internal/overrides"
+	"terraform-provider-azname/internal/suggest"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-// Looks up a resource definition by its type name.
+// GetResourceDefinition looks up a resource definition by its type name
+// (azurerm_key_vault), its Azure resource provider namespace
+// (Microsoft.KeyVault/vaults) or its CAF slug (kv). Namespaces and slugs that
+// match more than one resource type return an error listing the candidates.
 func GetResourceDefinition(resourceType string) (ResourceStructure, error) {
-	resource, ok := ResourceDefinitions[resourceType]
-	if !ok {
-		return ResourceStructure{}, fmt.Errorf("unknown resource type: %s", resourceType)
+	if resource, ok := ResourceDefinitions[resourceType]; ok {
+		return resource, nil
 	}
-	return resource, nil
+
+	// Slugs can be changed by overrides, so match against the live definitions
+	// rather than the generated ResourceMaps.
+	var candidates []string
+	if resourceType != "" {
+		for name, resource := range ResourceDefinitions {
+			if strings.EqualFold(resource.ResourceProviderNamespace, resourceType) || strings.EqualFold(resource.CafPrefix, resourceType) {
+				candidates = append(candidates, name)
+			}
+		}
+	}
+
+	switch len(candidates) {
+	case 0:
+		return ResourceStructure{}, fmt.Errorf("unknown resource type: %s%s", resourceType, suggest.DidYouMean(suggestResourceTypes(resourceType)))
+	case 1:
+		return ResourceDefinitions[candidates[0]], nil
+	default:
+		sort.Strings(candidates)
+		return ResourceStructure{}, fmt.Errorf("ambiguous resource type %q matches %s; use the full resource type name instead", resourceType, strings.Join(candidates, ", "))
+	}
+}
+
+// suggestResourceTypes returns the resource type names closest to an unknown
+// resource type, matching on the full name, the name without the azurerm_
+// prefix and the slug.
+func suggestResourceTypes(resourceType string) []string {
+	candidates := make(map[string][]string, len(ResourceDefinitions))
+	for name, resource := range ResourceDefinitions {
+		candidates[name] = []string{name, strings.TrimPrefix(name, "azurerm_"), resource.CafPrefix}
+	}
+	return suggest.Closest(resourceType, candidates, 5)
+}
+
+// resourceTemplates holds per resource type template overrides, keyed by
+// resource type name.
+var resourceTemplates = map[string]string{}
+
+// GetResourceTemplate returns the template override for a resource type, if
+// one is configured.
+func GetResourceTemplate(resourceTypeName string) (string, bool) {
+	template, ok := resourceTemplates[resourceTypeName]
+	return template, ok
 }
 
 var overridesOnce sync.Once
@@ -72,6 +119,24 @@ func ApplyOverrides(ctx context.Context, ovr *overrides.Overrides) {
 				}
 			}
 		}
+
+		// Apply template overrides, accepting any form of resource type
+		// that GetResourceDefinition understands
+		for resourceType, template := range ovr.ResourceTemplateOverrides {
+			resource, err := GetResourceDefinition(resourceType)
+			if err != nil {
+				tflog.Warn(ctx, "Skipping template override for unknown resource type", map[string]interface{}{
+					"resource_type": resourceType,
+					"error":         err.Error(),
+				})
+				continue
+			}
+			tflog.Debug(ctx, "Applying resource template override", map[string]interface{}{
+				"resource_type": resource.ResourceTypeName,
+				"template":      template,
+			})
+			resourceTemplates[resource.ResourceTypeName] = template
+		}
 	})
 }
 
@@ -94,4 +159,6 @@ type ResourceStructure struct {
 	Dashes bool `json:"dashes"`
 	// The scope of this name where it needs to be unique
 	Scope string `json:"scope,omitempty"`
+	// Azure resource provider namespace (e.g. Microsoft.KeyVault/vaults), if known
+	ResourceProviderNamespace string `json:"resource_provider_namespace,omitempty"`
 }
@@ -1,6 +1,6 @@
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2025-12-04 00:30:18.7430826 +1300 NZDT m=+0.003954001
+// 2026-10-16 19:08:12.701945525 +0000 UTC m=+0.003848490
 // using data from
 // resourceDefinition.json (resources with "official" field are from Microsoft docs)
 
@@ -8,401 +8,401 @@ package resources
 
 // ResourceDefinitions are a map of definitions for the resources supported
 var ResourceDefinitions = map[string]ResourceStructure{
-    "aks_node_pool_linux": {"aks_node_pool_linux", "npl", 1, 12,  false, "[^0-9a-z]", "^[a-z][0-9a-z]{0,11}$", false, "parent" },
-    "aks_node_pool_windows": {"aks_node_pool_windows", "npw", 1, 6,  false, "[^0-9a-z]", "^[a-z][0-9a-z]{0,5}$", false, "parent" },
-    "azurerm_aadb2c_directory": {"azurerm_aadb2c_directory", "aadb2c", 1, 75,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{0,73}[a-zA-Z0-9]$", true, "global" },
-    "azurerm_analysis_services_server": {"azurerm_analysis_services_server", "as", 3, 63,  true, "[^0-9a-z]", "^[a-z][a-z0-9]{2,62}$", false, "resourceGroup" },
-    "azurerm_api_management": {"azurerm_api_management", "apim", 1, 50,  false, "[^a-zA-Z0-9-]", "^[a-zA-Z][a-zA-Z0-9-]{0,48}[a-zA-Z0-9|]$", true, "global" },
-    "azurerm_api_management_api": {"azurerm_api_management_api", "apimapi", 1, 80,  false, "[^a-zA-Z0-9-]", "^[a-zA-Z][a-zA-Z0-9-]{0,78}[a-zA-Z0-9|]$", true, "global" },
-    "azurerm_api_management_api_operation_tag": {"azurerm_api_management_api_operation_tag", "apimapiopt", 1, 80,  false, "[^a-zA-Z0-9-]", "^[a-zA-Z][a-zA-Z0-9-]{0,78}[a-zA-Z0-9|]$", true, "global" },
-    "azurerm_api_management_backend": {"azurerm_api_management_backend", "apimbe", 1, 80,  false, "[^a-zA-Z0-9-]", "^[a-zA-Z][a-zA-Z0-9-]{0,78}[a-zA-Z0-9|]$", true, "global" },
-    "azurerm_api_management_certificate": {"azurerm_api_management_certificate", "apimcer", 1, 80,  false, "[^a-zA-Z0-9-]", "^[a-zA-Z][a-zA-Z0-9-]{0,78}[a-zA-Z0-9|]$", true, "global" },
-    "azurerm_api_management_gateway": {"azurerm_api_management_gateway", "apimgw", 1, 80,  false, "[^a-zA-Z0-9-]", "^[a-zA-Z][a-zA-Z0-9-]{0,78}[a-zA-Z0-9|]$", true, "global" },
-    "azurerm_api_management_group": {"azurerm_api_management_group", "apimgr", 1, 80,  false, "[^a-zA-Z0-9-]", "^[a-zA-Z][a-zA-Z0-9-]{0,78}[a-zA-Z0-9|]$", true, "global" },
-    "azurerm_api_management_logger": {"azurerm_api_management_logger", "apimlg", 1, 80,  false, "[^a-zA-Z0-9-]", "^[a-zA-Z][a-zA-Z0-9-]{0,78}[a-zA-Z0-9|]$", true, "global" },
-    "azurerm_api_management_service": {"azurerm_api_management_service", "apim", 1, 50,  false, "[^0-9A-Za-z-]", "^[a-z][a-zA-Z0-9-]{0,48}[a-zA-Z0-9]$", true, "global" },
-    "azurerm_app_configuration": {"azurerm_app_configuration", "appcg", 5, 50,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9-]{5,50}$", true, "resourceGroup" },
-    "azurerm_app_service": {"azurerm_app_service", "app", 2, 60,  false, "[^0-9A-Za-z-]", "^[0-9A-Za-z][0-9A-Za-z-]{0,58}[0-9a-zA-Z]$", true, "global" },
-    "azurerm_app_service_environment": {"azurerm_app_service_environment", "ase", 2, 36,  false, "[^0-9A-Za-z-]", "^[0-9A-Za-z-]{2,36}$", true, "resourceGroup" },
-    "azurerm_app_service_plan": {"azurerm_app_service_plan", "plan", 1, 40,  false, "[^0-9A-Za-z-]", "^[0-9A-Za-z-]{1,40}$", true, "resourceGroup" },
-    "azurerm_application_gateway": {"azurerm_application_gateway", "agw", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup" },
-    "azurerm_application_insights": {"azurerm_application_insights", "appi", 1, 260,  false, "[^0-9A-Za-z_.-]", "^[^%&\\?/. ][^%&\\?/]{0,258}[^%&\\?/. ]$", true, "resourceGroup" },
-    "azurerm_application_insights_web_test": {"azurerm_application_insights_web_test", "appiwt", 1, 64,  false, "[^0-9A-Za-z- ]", "^[a-zA-Z][a-zA-Z0-9- ]{0,62}[a-zA-Z0-9]$", true, "resourceGroup" },
-    "azurerm_application_security_group": {"azurerm_application_security_group", "asg", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup" },
-    "azurerm_automation_account": {"azurerm_automation_account", "aa", 6, 50,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z][a-zA-Z0-9-]{4,48}[a-zA-Z0-9]$", true, "resourceGroup" },
-    "azurerm_automation_certificate": {"azurerm_automation_certificate", "aacert", 1, 128,  false, `[^-\w\._\(\)]`, "^[^<>*%:.?\\+\\/]{0,127}[^<>*%:.?\\+\\/ ]$", true, "parent" },
-    "azurerm_automation_credential": {"azurerm_automation_credential", "aacred", 1, 128,  false, `[^-\w\._\(\)]`, "^[^<>*%:.?\\+\\/]{0,127}[^<>*%:.?\\+\\/ ]$", true, "parent" },
-    "azurerm_automation_hybrid_runbook_worker_group": {"azurerm_automation_hybrid_runbook_worker_group", "aahwg", 1, 128,  false, "[<>*%&:\\?.+/#]", "^([^<>*%&:\\?.+/#\\s]?[ ]?){0,127}[^<>*%&:\\?.+/#\\s]$", true, "parent" },
-    "azurerm_automation_job_schedule": {"azurerm_automation_job_schedule", "aajs", 1, 128,  false, `[^-\w\._\(\)]`, "^[^<>*%:.?\\+\\/]{0,127}[^<>*%:.?\\+\\/ ]$", true, "parent" },
-    "azurerm_automation_runbook": {"azurerm_automation_runbook", "aarun", 1, 63,  false, "[^0-9A-Za-z_]", "^[a-zA-Z][a-zA-Z0-9-]{0,62}$", true, "parent" },
-    "azurerm_automation_schedule": {"azurerm_automation_schedule", "aasched", 1, 128,  false, `[^-\w\._\(\)]`, "^[^<>*%:.?\\+\\/]{0,127}[^<>*%:.?\\+\\/ ]$", true, "parent" },
-    "azurerm_automation_variable": {"azurerm_automation_variable", "aavar", 1, 128,  false, `[^-\w\._\(\)]`, "^[^<>*%:.?\\+\\/]{0,127}[^<>*%:.?\\+\\/ ]$", true, "parent" },
-    "azurerm_availability_set": {"azurerm_availability_set", "avail", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup" },
-    "azurerm_bastion_host": {"azurerm_bastion_host", "bast", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent" },
-    "azurerm_batch_account": {"azurerm_batch_account", "ba", 3, 24,  true, "[^0-9a-z]", "^[a-z0-9]{3,24}$", false, "region" },
-    "azurerm_batch_application": {"azurerm_batch_application", "baapp", 1, 64,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9_-]{1,64}$", true, "parent" },
-    "azurerm_batch_certificate": {"azurerm_batch_certificate", "bacert", 5, 45,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9_-]{5,45}$", true, "parent" },
-    "azurerm_batch_pool": {"azurerm_batch_pool", "bapool", 3, 24,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9_-]{1,24}$", true, "parent" },
-    "azurerm_bot_channel_Email": {"azurerm_bot_channel_Email", "botmail", 2, 64,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{1,63}$", true, "parent" },
-    "azurerm_bot_channel_directline": {"azurerm_bot_channel_directline", "botline", 2, 64,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{1,63}$", true, "parent" },
-    "azurerm_bot_channel_ms_teams": {"azurerm_bot_channel_ms_teams", "botteams", 2, 64,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{1,63}$", true, "parent" },
-    "azurerm_bot_channel_slack": {"azurerm_bot_channel_slack", "botslack", 2, 64,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{1,63}$", true, "parent" },
-    "azurerm_bot_channels_registration": {"azurerm_bot_channels_registration", "botchan", 2, 64,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{1,63}$", true, "parent" },
-    "azurerm_bot_connection": {"azurerm_bot_connection", "botcon", 2, 64,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{1,63}$", true, "parent" },
-    "azurerm_bot_service_azure_bot": {"azurerm_bot_service_azure_bot", "botaz", 2, 64,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{1,63}$", true, "global" },
-    "azurerm_bot_web_app": {"azurerm_bot_web_app", "bot", 2, 64,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{1,63}$", true, "global" },
-    "azurerm_cdn_endpoint": {"azurerm_cdn_endpoint", "cdn", 1, 50,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{0,48}[a-zA-Z0-9]$", true, "global" },
-    "azurerm_cdn_frontdoor_custom_domain": {"azurerm_cdn_frontdoor_custom_domain", "cfdcd", 1, 260,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9]([a-zA-Z0-9-]{0,258}[a-zA-Z0-9])?$", true, "parent" },
-    "azurerm_cdn_frontdoor_endpoint": {"azurerm_cdn_frontdoor_endpoint", "cfde", 1, 46,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9]([a-zA-Z0-9-]{0,44}[a-zA-Z0-9])?$", true, "global" },
-    "azurerm_cdn_frontdoor_firewall_policy": {"azurerm_cdn_frontdoor_firewall_policy", "cfdfp", 1, 128,  false, "[^0-9A-Za-z]", "^[a-zA-Z][0-9a-zA-Z]{0,127}$", false, "resourceGroup" },
-    "azurerm_cdn_frontdoor_origin": {"azurerm_cdn_frontdoor_origin", "cfdo", 1, 90,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9]([a-zA-Z0-9-]{0,88}[a-zA-Z0-9])?$", true, "parent" },
-    "azurerm_cdn_frontdoor_origin_group": {"azurerm_cdn_frontdoor_origin_group", "cfdog", 1, 90,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9]([a-zA-Z0-9-]{0,88}[a-zA-Z0-9])?$", true, "parent" },
-    "azurerm_cdn_frontdoor_profile": {"azurerm_cdn_frontdoor_profile", "cfdp", 1, 90,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9]([a-zA-Z0-9-]{0,88}[a-zA-Z0-9])?$", true, "resourceGroup" },
-    "azurerm_cdn_frontdoor_route": {"azurerm_cdn_frontdoor_route", "cfdroute", 1, 90,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9]([a-zA-Z0-9-]{0,88}[a-zA-Z0-9])?$", true, "parent" },
-    "azurerm_cdn_frontdoor_rule": {"azurerm_cdn_frontdoor_rule", "cfdr", 1, 60,  false, "[^0-9A-Za-z]", "^[a-zA-Z][a-zA-Z0-9]{0,59}$", false, "parent" },
-    "azurerm_cdn_frontdoor_rule_set": {"azurerm_cdn_frontdoor_rule_set", "cfdrs", 1, 60,  false, "[^0-9A-Za-z]", "^[a-zA-Z][a-zA-Z0-9]{0,59}$", false, "parent" },
-    "azurerm_cdn_frontdoor_secret": {"azurerm_cdn_frontdoor_secret", "cfds", 2, 260,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{0,258}[a-zA-Z0-9]$", true, "parent" },
-    "azurerm_cdn_frontdoor_security_policy": {"azurerm_cdn_frontdoor_security_policy", "cfdsp", 1, 260,  false, "[^0-9A-Za-z_-]", "^[0-9A-Za-z_-]{1,260}$", true, "parent" },
-    "azurerm_cdn_profile": {"azurerm_cdn_profile", "cdnprof", 1, 260,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{0,258}[a-zA-Z0-9]$", true, "resourceGroup" },
-    "azurerm_cognitive_account": {"azurerm_cognitive_account", "cog", 2, 64,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{0,63}$", true, "resourceGroup" },
-    "azurerm_cognitive_deployment": {"azurerm_cognitive_deployment", "cog", 2, 64,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{0,63}$", true, "resourceGroup" },
-    "azurerm_communication_service": {"azurerm_communication_service", "acs", 1, 64,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9_-]{1,64}$", true, "parent" },
-    "azurerm_consumption_budget_resource_group": {"azurerm_consumption_budget_resource_group", "acbrg", 1, 63,  false, "[^a-zA-Z0-9-_]", "^[a-zA-Z0-9-_]{1,63}$", true, "resourceGroup" },
-    "azurerm_consumption_budget_subscription": {"azurerm_consumption_budget_subscription", "acbs", 1, 63,  false, "[^a-zA-Z0-9-_]", "^[a-zA-Z0-9-_]{1,63}$", true, "subscription" },
-    "azurerm_containerGroups": {"azurerm_containerGroups", "cg", 1, 63,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{0,61}[a-zA-Z0-9]$", true, "resourceGroup" },
-    "azurerm_container_app": {"azurerm_container_app", "ca", 1, 32,  true, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{0,30}[a-z0-9]$", true, "resourceGroup" },
-    "azurerm_container_app_environment": {"azurerm_container_app_environment", "cae", 1, 60,  false, "[^0-9A-Za-z-]", "^[0-9A-Za-z][0-9A-Za-z-]{0,58}[0-9a-zA-Z]$", true, "resourceGroup" },
-    "azurerm_container_registry": {"azurerm_container_registry", "cr", 1, 63,  true, "[^0-9A-Za-z]", "^[a-zA-Z0-9]{1,63}$", false, "resourceGroup" },
-    "azurerm_container_registry_webhook": {"azurerm_container_registry_webhook", "crwh", 1, 50,  false, "[^0-9A-Za-z]", "^[a-zA-Z0-9]{1,50}$", false, "resourceGroup" },
-    "azurerm_cosmosdb_account": {"azurerm_cosmosdb_account", "cosmos", 1, 44,  true, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{0,42}[a-z0-9]$", true, "resourceGroup" },
-    "azurerm_custom_provider": {"azurerm_custom_provider", "prov", 3, 64,  false, "[&%.?\\/]", "^[^&%?\\/]{2,63}[^&%.?\\/ ]$", true, "resourceGroup" },
-    "azurerm_dashboard": {"azurerm_dashboard", "dsb", 3, 160,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9-]{3,160}$", true, "parent" },
-    "azurerm_data_factory": {"azurerm_data_factory", "adf", 3, 63,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,61}[a-zA-Z0-9]$", true, "global" },
-    "azurerm_data_factory_dataset_azure_blob": {"azurerm_data_factory_dataset_azure_blob", "adfblob", 1, 260,  false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,258}[a-zA-Z0-9]$", true, "parent" },
-    "azurerm_data_factory_dataset_cosmosdb_sqlapi": {"azurerm_data_factory_dataset_cosmosdb_sqlapi", "adfsqlapi", 1, 260,  false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,258}[a-zA-Z0-9]$", true, "parent" },
-    "azurerm_data_factory_dataset_delimited_text": {"azurerm_data_factory_dataset_delimited_text", "adfdtext", 1, 260,  false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,258}[a-zA-Z0-9]$", true, "parent" },
-    "azurerm_data_factory_dataset_http": {"azurerm_data_factory_dataset_http", "adfhttp", 1, 260,  false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,258}[a-zA-Z0-9]$", true, "parent" },
-    "azurerm_data_factory_dataset_json": {"azurerm_data_factory_dataset_json", "adfjson", 1, 260,  false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,258}[a-zA-Z0-9]$", true, "parent" },
-    "azurerm_data_factory_dataset_mysql": {"azurerm_data_factory_dataset_mysql", "adfmysql", 1, 260,  false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,258}[a-zA-Z0-9]$", true, "parent" },
-    "azurerm_data_factory_dataset_postgresql": {"azurerm_data_factory_dataset_postgresql", "adfpsql", 1, 260,  false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,258}[a-zA-Z0-9]$", true, "parent" },
-    "azurerm_data_factory_dataset_sql_server_table": {"azurerm_data_factory_dataset_sql_server_table", "adfmssql", 1, 260,  false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,258}[a-zA-Z0-9]$", true, "parent" },
-    "azurerm_data_factory_integration_runtime_managed": {"azurerm_data_factory_integration_runtime_managed", "adfir", 3, 63,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,61}[a-zA-Z0-9]$", true, "parent" },
-    "azurerm_data_factory_linked_service_azure_blob_storage": {"azurerm_data_factory_linked_service_azure_blob_storage", "adflsabs", 1, 260,  false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,259}$", true, "parent" },
-    "azurerm_data_factory_linked_service_azure_databricks": {"azurerm_data_factory_linked_service_azure_databricks", "adflsadb", 1, 260,  false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,259}$", true, "parent" },
-    "azurerm_data_factory_linked_service_azure_function": {"azurerm_data_factory_linked_service_azure_function", "adflsaf", 1, 260,  false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,259}$", true, "parent" },
-    "azurerm_data_factory_linked_service_azure_sql_database": {"azurerm_data_factory_linked_service_azure_sql_database", "adflsasdb", 1, 260,  false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,259}$", true, "parent" },
-    "azurerm_data_factory_linked_service_cosmosdb": {"azurerm_data_factory_linked_service_cosmosdb", "adflsacdb", 1, 260,  false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,259}$", true, "parent" },
-    "azurerm_data_factory_linked_service_data_lake_storage_gen2": {"azurerm_data_factory_linked_service_data_lake_storage_gen2", "adfsvst", 1, 260,  false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,259}$", true, "parent" },
-    "azurerm_data_factory_linked_service_key_vault": {"azurerm_data_factory_linked_service_key_vault", "adfsvkv", 1, 260,  false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,259}$", true, "parent" },
-    "azurerm_data_factory_linked_service_mysql": {"azurerm_data_factory_linked_service_mysql", "adfsvmysql", 1, 260,  false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,259}$", true, "parent" },
-    "azurerm_data_factory_linked_service_postgresql": {"azurerm_data_factory_linked_service_postgresql", "adfsvpsql", 1, 260,  false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,259}$", true, "parent" },
-    "azurerm_data_factory_linked_service_sftp": {"azurerm_data_factory_linked_service_sftp", "adflsaftp", 1, 260,  false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,259}$", true, "parent" },
-    "azurerm_data_factory_linked_service_sql_server": {"azurerm_data_factory_linked_service_sql_server", "adfsvmssql", 1, 260,  false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,259}$", true, "parent" },
-    "azurerm_data_factory_linked_service_web": {"azurerm_data_factory_linked_service_web", "adfsvweb", 1, 260,  false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,259}$", true, "parent" },
-    "azurerm_data_factory_pipeline": {"azurerm_data_factory_pipeline", "adfpl", 1, 260,  false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,258}[a-zA-Z0-9]$", true, "parent" },
-    "azurerm_data_factory_trigger_schedule": {"azurerm_data_factory_trigger_schedule", "adftg", 1, 260,  false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,259}$", true, "parent" },
-    "azurerm_data_lake_analytics_account": {"azurerm_data_lake_analytics_account", "dla", 3, 24,  false, "[^0-9a-z]", "^[a-z0-9]{3,24}$", false, "global" },
-    "azurerm_data_lake_analytics_firewall_rule": {"azurerm_data_lake_analytics_firewall_rule", "dlfw", 3, 50,  false, "[^0-9a-z_-]", "^[a-z0-9-_]{3,50}$", true, "parent" },
-    "azurerm_data_lake_store": {"azurerm_data_lake_store", "dls", 3, 24,  false, "[^0-9a-z]", "^[a-z0-9]{3,24}$", false, "parent" },
-    "azurerm_data_lake_store_firewall_rule": {"azurerm_data_lake_store_firewall_rule", "dlsfw", 3, 50,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{3,50}$", true, "parent" },
-    "azurerm_data_protection_backup_policy_blob_storage": {"azurerm_data_protection_backup_policy_blob_storage", "dpbpb", 3, 150,  false, `[^a-zA-Z0-9-]`, "^[a-zA-Z][a-zA-Z0-9\\-]{1,148}[a-zA-Z0-9]$", true, "resourceGroup" },
-    "azurerm_data_protection_backup_policy_disk": {"azurerm_data_protection_backup_policy_disk", "dpbpd", 3, 150,  false, `[^a-zA-Z0-9-]`, "^[a-zA-Z][a-zA-Z0-9\\-]{1,148}[a-zA-Z0-9]$", true, "resourceGroup" },
-    "azurerm_data_protection_backup_policy_postgresql": {"azurerm_data_protection_backup_policy_postgresql", "dpbpp", 3, 150,  false, `[^a-zA-Z0-9-]`, "^[a-zA-Z][a-zA-Z0-9\\-]{1,148}[a-zA-Z0-9]$", true, "resourceGroup" },
-    "azurerm_data_protection_backup_policy_postgresql_flexible_server": {"azurerm_data_protection_backup_policy_postgresql_flexible_server", "dpbppf", 3, 150,  false, `[^a-zA-Z0-9-]`, "^[a-zA-Z][a-zA-Z0-9\\-]{1,148}[a-zA-Z0-9]$", true, "resourceGroup" },
-    "azurerm_data_protection_backup_vault": {"azurerm_data_protection_backup_vault", "dpbv", 2, 50,  false, `[^a-zA-Z0-9-]`, "^[a-zA-Z][a-zA-Z0-9-]{1,49}$", true, "resourceGroup" },
-    "azurerm_database_migration_project": {"azurerm_database_migration_project", "migr", 2, 57,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{1,56}$", true, "parent" },
-    "azurerm_database_migration_service": {"azurerm_database_migration_service", "dms", 2, 62,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{1,61}$", true, "resourceGroup" },
-    "azurerm_databricks_workspace": {"azurerm_databricks_workspace", "dbw", 3, 64,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{3,64}$", true, "resourceGroup" },
-    "azurerm_dedicated_host": {"azurerm_dedicated_host", "dh", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup" },
-    "azurerm_dedicated_host_group": {"azurerm_dedicated_host_group", "dhg", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup" },
-    "azurerm_dev_center": {"azurerm_dev_center", "dc", 3, 26,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,24}[a-zA-Z0-9]$", true, "resourceGroup" },
-    "azurerm_dev_center_catalog": {"azurerm_dev_center_catalog", "dcc", 3, 63,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,61}[a-zA-Z0-9]$", true, "parent" },
-    "azurerm_dev_center_dev_box_definition": {"azurerm_dev_center_dev_box_definition", "dcdb", 3, 63,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,61}[a-zA-Z0-9]$", true, "parent" },
-    "azurerm_dev_center_environment_type": {"azurerm_dev_center_environment_type", "dcet", 3, 63,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,61}[a-zA-Z0-9]$", true, "parent" },
-    "azurerm_dev_center_gallery": {"azurerm_dev_center_gallery", "dcg", 1, 80,  false, "[^0-9A-Za-z_.]", "^[a-zA-Z0-9]([a-zA-Z0-9_.]{0,78}[a-zA-Z0-9])?$", false, "parent" },
-    "azurerm_dev_center_network_connection": {"azurerm_dev_center_network_connection", "dcnc", 3, 63,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,61}[a-zA-Z0-9]$", true, "resourceGroup" },
-    "azurerm_dev_center_project": {"azurerm_dev_center_project", "dcp", 3, 63,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,61}[a-zA-Z0-9]$", true, "resourceGroup" },
-    "azurerm_dev_center_project_environment_type": {"azurerm_dev_center_project_environment_type", "dcpet", 3, 63,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,61}[a-zA-Z0-9]$", true, "parent" },
-    "azurerm_dev_test_lab": {"azurerm_dev_test_lab", "lab", 1, 50,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,50}$", true, "resourceGroup" },
-    "azurerm_dev_test_linux_virtual_machine": {"azurerm_dev_test_linux_virtual_machine", "labvm", 1, 64,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9-]{1,64}$", true, "parent" },
-    "azurerm_dev_test_windows_virtual_machine": {"azurerm_dev_test_windows_virtual_machine", "labvm", 1, 15,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9-]{1,15}$", true, "parent" },
-    "azurerm_digital_twins_endpoint_eventgrid": {"azurerm_digital_twins_endpoint_eventgrid", "adteg", 3, 50,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9_-]{1,50}$", true, "parent" },
-    "azurerm_digital_twins_endpoint_eventhub": {"azurerm_digital_twins_endpoint_eventhub", "adteh", 3, 50,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9_-]{1,50}$", true, "parent" },
-    "azurerm_digital_twins_endpoint_servicebus": {"azurerm_digital_twins_endpoint_servicebus", "adtsb", 3, 50,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9_-]{1,50}$", true, "parent" },
-    "azurerm_digital_twins_instance": {"azurerm_digital_twins_instance", "adt", 4, 63,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9_-]{1,63}$", true, "subscription" },
-    "azurerm_disk_encryption_set": {"azurerm_disk_encryption_set", "des", 1, 80,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,80}$", true, "resourceGroup" },
-    "azurerm_dns_a_record": {"azurerm_dns_a_record", "dnsrec", 1, 80,  false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent" },
-    "azurerm_dns_aaaa_record": {"azurerm_dns_aaaa_record", "dnsrec", 1, 80,  false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent" },
-    "azurerm_dns_caa_record": {"azurerm_dns_caa_record", "dnsrec", 1, 80,  false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent" },
-    "azurerm_dns_cname_record": {"azurerm_dns_cname_record", "dnsrec", 1, 80,  false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent" },
-    "azurerm_dns_mx_record": {"azurerm_dns_mx_record", "dnsrec", 1, 80,  false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent" },
-    "azurerm_dns_ns_record": {"azurerm_dns_ns_record", "dnsrec", 1, 80,  false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent" },
-    "azurerm_dns_ptr_record": {"azurerm_dns_ptr_record", "dnsrec", 1, 80,  false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent" },
-    "azurerm_dns_txt_record": {"azurerm_dns_txt_record", "dnsrec", 1, 80,  false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent" },
-    "azurerm_dns_zone": {"azurerm_dns_zone", "dns", 1, 63,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,61}[a-zA-Z0-9_]$", true, "resourceGroup" },
-    "azurerm_eventgrid_domain": {"azurerm_eventgrid_domain", "egd", 3, 50,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9-]{3,50}$", true, "resourceGroup" },
-    "azurerm_eventgrid_domain_topic": {"azurerm_eventgrid_domain_topic", "egdt", 3, 50,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9-]{3,50}$", true, "parent" },
-    "azurerm_eventgrid_event_subscription": {"azurerm_eventgrid_event_subscription", "egs", 3, 64,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9-]{3,64}$", true, "resourceGroup" },
-    "azurerm_eventgrid_topic": {"azurerm_eventgrid_topic", "egt", 3, 50,  false, `[^0-9A-Za-z-]`, "^[a-zA-Z0-9-]{3,50}$", true, "resourceGroup" },
-    "azurerm_eventhub": {"azurerm_eventhub", "evh", 1, 50,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,48}[a-zA-Z0-9]$", true, "parent" },
-    "azurerm_eventhub_authorization_rule": {"azurerm_eventhub_authorization_rule", "ehar", 1, 50,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,48}[a-zA-Z0-9]$", true, "parent" },
-    "azurerm_eventhub_consumer_group": {"azurerm_eventhub_consumer_group", "ehcg", 1, 50,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,48}[a-zA-Z0-9]$", true, "parent" },
-    "azurerm_eventhub_namespace": {"azurerm_eventhub_namespace", "ehn", 1, 50,  false, "[^0-9A-Za-z-]", "^[a-zA-Z][a-zA-Z0-9-]{0,48}[a-zA-Z0-9]$", true, "global" },
-    "azurerm_eventhub_namespace_authorization_rule": {"azurerm_eventhub_namespace_authorization_rule", "ehnar", 1, 50,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,48}[a-zA-Z0-9]$", true, "parent" },
-    "azurerm_eventhub_namespace_disaster_recovery_config": {"azurerm_eventhub_namespace_disaster_recovery_config", "ehdr", 1, 50,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,48}[a-zA-Z0-9]$", true, "parent" },
-    "azurerm_express_route_circuit": {"azurerm_express_route_circuit", "erc", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup" },
-    "azurerm_express_route_gateway": {"azurerm_express_route_gateway", "ergw", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup" },
-    "azurerm_federated_identity_credential": {"azurerm_federated_identity_credential", "fedcred", 3, 120,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9][a-zA-Z0-9_-]{2,119}$", true, "parent" },
-    "azurerm_firewall": {"azurerm_firewall", "fw", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup" },
-    "azurerm_firewall_application_rule_collection": {"azurerm_firewall_application_rule_collection", "fwapp", 1, 80,  false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent" },
-    "azurerm_firewall_ip_configuration": {"azurerm_firewall_ip_configuration", "fwipconf", 1, 80,  false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup" },
-    "azurerm_firewall_nat_rule_collection": {"azurerm_firewall_nat_rule_collection", "fwnatrc", 1, 80,  false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent" },
-    "azurerm_firewall_network_rule_collection": {"azurerm_firewall_network_rule_collection", "fwnetrc", 1, 80,  false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent" },
-    "azurerm_firewall_policy": {"azurerm_firewall_policy", "afwp", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup" },
-    "azurerm_frontdoor": {"azurerm_frontdoor", "fd", 5, 64,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{3,62}[a-zA-Z0-9]$", true, "global" },
-    "azurerm_frontdoor_firewall_policy": {"azurerm_frontdoor_firewall_policy", "fdfw", 1, 80,  false, "[^0-9A-Za-z]", "^[a-zA-Z0-9][a-zA-Z0-9]{0,78}[a-zA-Z0-9]$", false, "global" },
-    "azurerm_function_app": {"azurerm_function_app", "fa", 2, 60,  false, "[^0-9A-Za-z-]", "^[0-9A-Za-z][0-9A-Za-z-]{0,58}[0-9a-zA-Z]$", true, "global" },
-    "azurerm_function_app_slot": {"azurerm_function_app_slot", "fas", 2, 59,  false, "[^0-9A-Za-z-]", "^[0-9A-Za-z][0-9A-Za-z-]{0,57}[0-9a-zA-Z]$", true, "global" },
-    "azurerm_hdinsight_hadoop_cluster": {"azurerm_hdinsight_hadoop_cluster", "hadoop", 3, 59,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,57}[a-zA-Z0-9]$", true, "global" },
-    "azurerm_hdinsight_hbase_cluster": {"azurerm_hdinsight_hbase_cluster", "hbase", 3, 59,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,57}[a-zA-Z0-9]$", true, "global" },
-    "azurerm_hdinsight_interactive_query_cluster": {"azurerm_hdinsight_interactive_query_cluster", "iqr", 3, 59,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,57}[a-zA-Z0-9]$", true, "global" },
-    "azurerm_hdinsight_kafka_cluster": {"azurerm_hdinsight_kafka_cluster", "kafka", 3, 59,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,57}[a-zA-Z0-9]$", true, "global" },
-    "azurerm_hdinsight_ml_services_cluster": {"azurerm_hdinsight_ml_services_cluster", "mls", 3, 59,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,57}[a-zA-Z0-9]$", true, "global" },
-    "azurerm_hdinsight_rserver_cluster": {"azurerm_hdinsight_rserver_cluster", "rser", 3, 59,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,57}[a-zA-Z0-9]$", true, "global" },
-    "azurerm_hdinsight_spark_cluster": {"azurerm_hdinsight_spark_cluster", "spark", 3, 59,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,57}[a-zA-Z0-9]$", true, "global" },
-    "azurerm_hdinsight_storm_cluster": {"azurerm_hdinsight_storm_cluster", "storm", 3, 59,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,57}[a-zA-Z0-9]$", true, "global" },
-    "azurerm_healthcare_dicom_service": {"azurerm_healthcare_dicom_service", "dicom", 3, 24,  true, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{1,22}[a-z0-9]$", true, "parent" },
-    "azurerm_healthcare_fhir_service": {"azurerm_healthcare_fhir_service", "fhir", 3, 24,  true, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{1,22}[a-z0-9]$", true, "parent" },
-    "azurerm_healthcare_medtech_service": {"azurerm_healthcare_medtech_service", "medtech", 3, 24,  true, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{1,22}[a-z0-9]$", true, "parent" },
-    "azurerm_healthcare_service": {"azurerm_healthcare_service", "hcasvc", 3, 24,  true, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{1,22}[a-z0-9]$", true, "global" },
-    "azurerm_healthcare_workspace": {"azurerm_healthcare_workspace", "hcw", 3, 24,  true, "[^0-9a-z]", "^[a-z0-9]{3,24}$", false, "global" },
-    "azurerm_image": {"azurerm_image", "img", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup" },
-    "azurerm_integration_service_environment": {"azurerm_integration_service_environment", "lappise", 1, 80,  false, "[^0-9A-Za-z\\-\\_\\.]", "^[0-9A-Za-z\\-\\_\\.]{1,80}$", true, "resourceGroup" },
-    "azurerm_iot_security_device_group": {"azurerm_iot_security_device_group", "iotdg", 1, 32,  false, "[^0-9A-Za-z-._]", "^[a-zA-Z0-9-._]{1,32}$", true, "parent" },
-    "azurerm_iot_security_solution": {"azurerm_iot_security_solution", "iotss", 1, 260,  false, "[^0-9A-Za-z-_]", "^[a-zA-Z0-9-_]{1,260}$", true, "resourceGroup" },
-    "azurerm_iotcentral_application": {"azurerm_iotcentral_application", "iotapp", 2, 63,  true, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{0,61}[a-z0-9]$", true, "global" },
-    "azurerm_iothub": {"azurerm_iothub", "iot", 3, 50,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,48}[a-z0-9]$", true, "global" },
-    "azurerm_iothub_certificate": {"azurerm_iothub_certificate", "iotcert", 1, 64,  false, "[^0-9A-Za-z-._]", "^[a-zA-Z0-9-._]{1,64}$", true, "parent" },
-    "azurerm_iothub_consumer_group": {"azurerm_iothub_consumer_group", "iotcg", 1, 50,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9-._]{1,50}$", true, "parent" },
-    "azurerm_iothub_dps": {"azurerm_iothub_dps", "dps", 3, 64,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9-]{1,63}[a-zA-Z0-9]$", true, "resourceGroup" },
-    "azurerm_iothub_dps_certificate": {"azurerm_iothub_dps_certificate", "dpscert", 1, 64,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9-._]{1,64}$", true, "parent" },
-    "azurerm_iothub_dps_shared_access_policy": {"azurerm_iothub_dps_shared_access_policy", "dpssap", 1, 64,  false, "[^0-9A-Za-z-._]", "^[a-zA-Z0-9-._]{1,64}$", true, "parent" },
-    "azurerm_iothub_shared_access_policy": {"azurerm_iothub_shared_access_policy", "iotsap", 1, 64,  false, "[^0-9A-Za-z-._]", "^[a-zA-Z0-9-._]{1,64}$", true, "parent" },
-    "azurerm_ip_group": {"azurerm_ip_group", "ipgr", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup" },
-    "azurerm_key_vault": {"azurerm_key_vault", "kv", 3, 24,  false, "[^0-9A-Za-z-]", "^[a-zA-Z][a-zA-Z0-9-]{1,22}[a-zA-Z0-9]$", true, "global" },
-    "azurerm_key_vault_certificate": {"azurerm_key_vault_certificate", "kvc", 1, 127,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9-]{1,127}$", true, "parent" },
-    "azurerm_key_vault_key": {"azurerm_key_vault_key", "kvk", 1, 127,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9-]{1,127}$", true, "parent" },
-    "azurerm_key_vault_secret": {"azurerm_key_vault_secret", "kvs", 1, 127,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9-]{1,127}$", true, "parent" },
-    "azurerm_kubernetes_cluster": {"azurerm_kubernetes_cluster", "aks", 1, 63,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9][a-zA-Z0-9-_]{0,61}[a-zA-Z0-9]$", true, "resourceGroup" },
-    "azurerm_kubernetes_fleet_manager": {"azurerm_kubernetes_fleet_manager", "fleet", 1, 63,  true, "[^0-9a-z-]", "^[0-9a-z]([0-9a-z-]{0,61}[0-9a-z])?$", true, "resourceGroup" },
-    "azurerm_kusto_cluster": {"azurerm_kusto_cluster", "kc", 4, 22,  false, "[^0-9A-Za-z]", "^[a-z][a-z0-9]{3,21}$", false, "global" },
-    "azurerm_kusto_database": {"azurerm_kusto_database", "kdb", 1, 260,  false, "[^0-9A-Za-z- .]", "^[a-zA-Z0-9- .]{1,260}$", true, "parent" },
-    "azurerm_kusto_eventhub_data_connection": {"azurerm_kusto_eventhub_data_connection", "kehc", 1, 40,  false, "[^0-9A-Za-z- .]", "^[a-zA-Z0-9- .]{1,40}$", true, "parent" },
-    "azurerm_lb": {"azurerm_lb", "lb", 1, 80,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup" },
-    "azurerm_lb_backend_address_pool": {"azurerm_lb_backend_address_pool", "adt", 4, 63,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9_-]{1,63}$", true, "subscription" },
-    "azurerm_lb_backend_pool": {"azurerm_lb_backend_pool", "adt", 4, 63,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9_-]{1,63}$", true, "subscription" },
-    "azurerm_lb_nat_pool": {"azurerm_lb_nat_pool", "adt", 4, 63,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9_-]{1,63}$", true, "subscription" },
-    "azurerm_lb_nat_rule": {"azurerm_lb_nat_rule", "lbnatrl", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent" },
-    "azurerm_lb_outbound_rule": {"azurerm_lb_outbound_rule", "adt", 4, 63,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9_-]{1,63}$", true, "subscription" },
-    "azurerm_lb_probe": {"azurerm_lb_probe", "adt", 4, 63,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9_-]{1,63}$", true, "subscription" },
-    "azurerm_lb_rule": {"azurerm_lb_rule", "adt", 4, 63,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9_-]{1,63}$", true, "subscription" },
-    "azurerm_linux_virtual_machine": {"azurerm_linux_virtual_machine", "vm", 1, 64,  false, "[\\/\"\\[\\]:|<>+=;,?*@&_]", "^[^\\/\"\\[\\]:|<>+=;,?*@&_][^\\/\"\\[\\]:|<>+=;,?*@&]{0,62}[^\\/\"\\[\\]:|<>+=;,?*@&.-]$", true, "resourceGroup" },
-    "azurerm_linux_virtual_machine_scale_set": {"azurerm_linux_virtual_machine_scale_set", "vmss", 1, 64,  false, "[\\/\"\\[\\]:|<>+=;,?*@&_]", "^[^\\/\"\\[\\]:|<>+=;,?*@&_][^\\/\"\\[\\]:|<>+=;,?*@&]{0,62}[^\\/\"\\[\\]:|<>+=;,?*@&.-]$", true, "resourceGroup" },
-    "azurerm_linux_web_app": {"azurerm_linux_web_app", "lwapp", 2, 60,  false, "[^0-9A-Za-z-]", "^[0-9A-Za-z][0-9A-Za-z-]{0,58}[0-9a-zA-Z]$", true, "global" },
-    "azurerm_load_test": {"azurerm_load_test", "load", 1, 64,  false, "[^0-9A-Za-z-_]", "^[a-zA-Z][a-zA-Z0-9-_]{0,62}[a-zA-Z0-9|]$", true, "global" },
-    "azurerm_local_network_gateway": {"azurerm_local_network_gateway", "lgw", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup" },
-    "azurerm_log_analytics_cluster": {"azurerm_log_analytics_cluster", "logc", 4, 63,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{2,61}[a-zA-Z0-9]$", true, "resourceGroup" },
-    "azurerm_log_analytics_query_pack": {"azurerm_log_analytics_query_pack", "laqp", 4, 63,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{2,61}[a-zA-Z0-9]$", true, "parent" },
-    "azurerm_log_analytics_solution": {"azurerm_log_analytics_solution", "las", 4, 63,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{2,61}[a-zA-Z0-9]$", true, "parent" },
-    "azurerm_log_analytics_storage_insights": {"azurerm_log_analytics_storage_insights", "lasi", 4, 63,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{2,61}[a-zA-Z0-9]$", true, "parent" },
-    "azurerm_log_analytics_workspace": {"azurerm_log_analytics_workspace", "log", 4, 63,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{2,61}[a-zA-Z0-9]$", true, "parent" },
-    "azurerm_logic_app_action_custom": {"azurerm_logic_app_action_custom", "lappac", 1, 80,  false, "[^0-9A-Za-z\\(\\-\\)\\_\\.]", "^[0-9A-Za-z\\(\\-\\)\\_\\.]{1,80}$", true, "resourceGroup" },
-    "azurerm_logic_app_action_http": {"azurerm_logic_app_action_http", "lappah", 1, 80,  false, "[^0-9A-Za-z\\(\\-\\)\\_\\.]", "^[0-9A-Za-z\\(\\-\\)\\_\\.]{1,80}$", true, "resourceGroup" },
-    "azurerm_logic_app_integration_account": {"azurerm_logic_app_integration_account", "lappia", 1, 80,  false, "[^0-9A-Za-z\\(\\-\\)\\_\\.]", "^[0-9A-Za-z\\(\\-\\)\\_\\.]{1,80}$", true, "resourceGroup" },
-    "azurerm_logic_app_trigger_custom": {"azurerm_logic_app_trigger_custom", "lapptc", 1, 80,  false, "[^0-9A-Za-z\\(\\-\\)\\_\\.]", "^[0-9A-Za-z\\(\\-\\)\\_\\.]{1,80}$", true, "resourceGroup" },
-    "azurerm_logic_app_trigger_http_request": {"azurerm_logic_app_trigger_http_request", "lappth", 1, 80,  false, "[^0-9A-Za-z\\(\\-\\)\\_\\.]", "^[0-9A-Za-z\\(\\-\\)\\_\\.]{1,80}$", true, "resourceGroup" },
-    "azurerm_logic_app_trigger_recurrence": {"azurerm_logic_app_trigger_recurrence", "lapptc", 1, 80,  false, "[^0-9A-Za-z\\(\\-\\)\\_\\.]", "^[0-9A-Za-z\\(\\-\\)\\_\\.]{1,80}$", true, "resourceGroup" },
-    "azurerm_logic_app_workflow": {"azurerm_logic_app_workflow", "lapp", 1, 80,  false, "[^0-9A-Za-z\\(\\-\\)\\_\\.]", "^[0-9A-Za-z\\(\\-\\)\\_\\.]{1,80}$", true, "resourceGroup" },
-    "azurerm_machine_learning_compute_instance": {"azurerm_machine_learning_compute_instance", "amlci", 1, 16,  false, "[^0-9a-z-]", "^[a-zA-Z0-9][a-z0-9-]{0,14}[a-zA-Z0-9]$", true, "parent" },
-    "azurerm_machine_learning_workspace": {"azurerm_machine_learning_workspace", "mlw", 1, 260,  false, "[<>*%:.?\\+\\/]", "^[^<>*%:.?\\+\\/]{0,259}[^<>*%:.?\\+\\/ ]$", true, "resourceGroup" },
-    "azurerm_maintenance_configuration": {"azurerm_maintenance_configuration", "mcf", 1, 60,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,58}[a-zA-Z0-9_]$", true, "resourceGroup" },
-    "azurerm_managed_disk": {"azurerm_managed_disk", "dsk", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup" },
-    "azurerm_maps_account": {"azurerm_maps_account", "map", 1, 98,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,97}$", true, "resourceGroup" },
-    "azurerm_mariadb_database": {"azurerm_mariadb_database", "mariadb", 1, 63,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,63}$", true, "parent" },
-    "azurerm_mariadb_firewall_rule": {"azurerm_mariadb_firewall_rule", "mariafw", 1, 128,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,128}$", true, "parent" },
-    "azurerm_mariadb_server": {"azurerm_mariadb_server", "maria", 3, 63,  false, "[^0-9A-Za-z-]", "^[a-z0-9][a-zA-Z0-9-]{1,61}[a-z0-9]$", true, "global" },
-    "azurerm_mariadb_virtual_network_rule": {"azurerm_mariadb_virtual_network_rule", "mariavn", 1, 128,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,128}$", true, "parent" },
-    "azurerm_monitor_action_group": {"azurerm_monitor_action_group", "amag", 1, 260,  false, `[^~!@$^*()\[\]\{\}_\-="';,0-9A-Za-z _.-]`, "^[^|:<>+#%&\\?/]{0,259}[^|:<>+#%&\\?/. ]$", true, "resourceGroup" },
-    "azurerm_monitor_activity_log_alert": {"azurerm_monitor_activity_log_alert", "adfmysql", 1, 260,  false, "[^0-9A-Za-z<>*%:&?#\\+\\/]", "^[^<>*%:&?#\\+\\/]{0,259}[^<>*%:&.?#\\+\\/]$", true, "parent" },
-    "azurerm_monitor_autoscale_setting": {"azurerm_monitor_autoscale_setting", "amas", 2, 64,  false, "[^0-9A-Za-z _.-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{0,62}[a-zA-Z0-9]$", true, "resourceGroup" },
-    "azurerm_monitor_data_collection_endpoint": {"azurerm_monitor_data_collection_endpoint", "dce", 3, 44,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,42}[a-zA-Z0-9]$", true, "resourceGroup" },
-    "azurerm_monitor_data_collection_rule": {"azurerm_monitor_data_collection_rule", "dcr", 3, 44,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,42}[a-zA-Z0-9]$", true, "resourceGroup" },
-    "azurerm_monitor_diagnostic_setting": {"azurerm_monitor_diagnostic_setting", "amds", 1, 260,  false, "[^0-9A-Za-z _.-]", "^[a-zA-Z0-9 ][a-zA-Z0-9-._ ]{0,258}[a-zA-Z0-9_]$", true, "parent" },
-    "azurerm_monitor_metric_alert": {"azurerm_monitor_metric_alert", "ma", 1, 251,  false, "[<>*%&:\\?+/#@{}]", "^[^<>*%&:\\?+/#@{}]{0,250}[^<>*%&:\\?+/#@{}. ]$", true, "resourceGroup" },
-    "azurerm_monitor_private_link_scope": {"azurerm_monitor_private_link_scope", "ampls", 1, 255,  false, "[^0-9A-Za-z-._()]", "^[0-9A-Za-z-._()]{0,254}[0-9A-Za-z-_()]$", true, "resourceGroup" },
-    "azurerm_monitor_scheduled_query_rules_alert": {"azurerm_monitor_scheduled_query_rules_alert", "schqra", 1, 260,  false, "[<>*%&:\\?/#{}]", "^[^<>*%&:\\?/#{}]{0,259}[^<>*%&:\\?/#{}. ]$", true, "resourceGroup" },
-    "azurerm_mssql_database": {"azurerm_mssql_database", "sqldb", 1, 128,  false, "[<>*%:.?\\+\\/ ]", "^[^<>*%:.?\\+\\/]{1,127}[^<>*%:.?\\+\\/ ]$", true, "parent" },
-    "azurerm_mssql_elasticpool": {"azurerm_mssql_elasticpool", "sqlep", 1, 128,  false, "[<>*%:.?\\+\\/]", "^[^<>*%:.?\\+\\/]{1,127}[^<>*%:.?\\+\\/ ]$", true, "parent" },
-    "azurerm_mssql_mi": {"azurerm_mssql_mi", "sqlmi", 1, 63,  true, "[^0-9A-Za-z-]", "^[a-z0-9][a-z0-9-]{0,61}[a-z0-9]$", true, "global" },
-    "azurerm_mssql_server": {"azurerm_mssql_server", "sql", 1, 63,  true, "[^0-9A-Za-z-]", "^[a-z0-9][a-z0-9-]{0,61}[a-z0-9]$", true, "global" },
-    "azurerm_mysql_database": {"azurerm_mysql_database", "mysqldb", 1, 63,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,63}$", true, "parent" },
-    "azurerm_mysql_firewall_rule": {"azurerm_mysql_firewall_rule", "mysqlfw", 1, 128,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,128}$", true, "parent" },
-    "azurerm_mysql_flexible_server": {"azurerm_mysql_flexible_server", "mysqlf", 3, 63,  false, "[^0-9A-Za-z-]", "^[a-z0-9][a-zA-Z0-9-]{1,61}[a-z0-9]$", true, "global" },
-    "azurerm_mysql_flexible_server_database": {"azurerm_mysql_flexible_server_database", "mysqlfdb", 1, 63,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,63}$", true, "parent" },
-    "azurerm_mysql_flexible_server_firewall_rule": {"azurerm_mysql_flexible_server_firewall_rule", "mysqlffw", 1, 128,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,128}$", true, "parent" },
-    "azurerm_mysql_server": {"azurerm_mysql_server", "mysql", 3, 63,  false, "[^0-9A-Za-z-]", "^[a-z0-9][a-zA-Z0-9-]{1,61}[a-z0-9]$", true, "global" },
-    "azurerm_mysql_virtual_network_rule": {"azurerm_mysql_virtual_network_rule", "mysqlvn", 1, 128,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,128}$", true, "parent" },
-    "azurerm_netapp_account": {"azurerm_netapp_account", "ana", 1, 128,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9][a-zA-Z0-9-_]{0,126}[a-zA-Z0-9]$", true, "resourceGroup" },
-    "azurerm_netapp_pool": {"azurerm_netapp_pool", "anp", 1, 63,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9][a-zA-Z0-9-_]{0,61}[a-zA-Z0-9]$", true, "resourceGroup" },
-    "azurerm_netapp_snapshot": {"azurerm_netapp_snapshot", "ans", 1, 63,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9][a-zA-Z0-9-_]{0,61}[a-zA-Z0-9]$", true, "resourceGroup" },
-    "azurerm_netapp_volume": {"azurerm_netapp_volume", "anv", 1, 63,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9][a-zA-Z0-9-_]{0,61}[a-zA-Z0-9]$", true, "resourceGroup" },
-    "azurerm_network_ddos_protection_plan": {"azurerm_network_ddos_protection_plan", "ddospp", 1, 80,  false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent" },
-    "azurerm_network_interface": {"azurerm_network_interface", "nic", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup" },
-    "azurerm_network_security_group": {"azurerm_network_security_group", "nsg", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup" },
-    "azurerm_network_security_group_rule": {"azurerm_network_security_group_rule", "nsgr", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent" },
-    "azurerm_network_security_rule": {"azurerm_network_security_rule", "nsgr", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent" },
-    "azurerm_network_watcher": {"azurerm_network_watcher", "nw", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup" },
-    "azurerm_nginx_deployment": {"azurerm_nginx_deployment", "nginx", 1, 30,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9]([a-zA-Z0-9-]{0,28}[a-zA-Z0-9])?$", true, "resourceGroup" },
-    "azurerm_notification_hub": {"azurerm_notification_hub", "nh", 1, 260,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,259}$", true, "parent" },
-    "azurerm_notification_hub_authorization_rule": {"azurerm_notification_hub_authorization_rule", "dnsrec", 1, 256,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,255}$", true, "parent" },
-    "azurerm_notification_hub_namespace": {"azurerm_notification_hub_namespace", "dnsrec", 6, 50,  false, "[^0-9A-Za-z-]", "^[a-zA-Z][a-zA-Z0-9-]{4,48}[a-zA-Z0-9]$", true, "global" },
-    "azurerm_point_to_site_vpn_gateway": {"azurerm_point_to_site_vpn_gateway", "vpngw", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup" },
-    "azurerm_portal_dashboard": {"azurerm_portal_dashboard", "dsb", 3, 160,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9-]{3,160}$", true, "parent" },
-    "azurerm_postgresql_database": {"azurerm_postgresql_database", "psqldb", 1, 63,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,63}$", true, "parent" },
-    "azurerm_postgresql_firewall_rule": {"azurerm_postgresql_firewall_rule", "psqlfw", 1, 128,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,128}$", true, "parent" },
-    "azurerm_postgresql_flexible_server": {"azurerm_postgresql_flexible_server", "psqlf", 3, 63,  true, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{1,61}[a-z0-9]$", true, "global" },
-    "azurerm_postgresql_flexible_server_database": {"azurerm_postgresql_flexible_server_database", "psqlfdb", 1, 63,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,63}$", true, "parent" },
-    "azurerm_postgresql_flexible_server_firewall_rule": {"azurerm_postgresql_flexible_server_firewall_rule", "psqlffw", 1, 128,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,128}$", true, "parent" },
-    "azurerm_postgresql_server": {"azurerm_postgresql_server", "psql", 3, 63,  false, "[^0-9A-Za-z-]", "^[a-z0-9][a-zA-Z0-9-]{1,61}[a-z0-9]$", true, "global" },
-    "azurerm_postgresql_virtual_network_rule": {"azurerm_postgresql_virtual_network_rule", "psqlvn", 1, 128,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,128}$", true, "parent" },
-    "azurerm_powerbi_embedded": {"azurerm_powerbi_embedded", "pbi", 3, 63,  false, "[^0-9a-z]", "^[a-z0-9][a-z0-9]{2,62}$", false, "region" },
-    "azurerm_private_dns_a_record": {"azurerm_private_dns_a_record", "pdnsrec", 1, 80,  false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent" },
-    "azurerm_private_dns_aaaa_record": {"azurerm_private_dns_aaaa_record", "pdnsrec", 1, 80,  false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent" },
-    "azurerm_private_dns_cname_record": {"azurerm_private_dns_cname_record", "pdnsrec", 1, 80,  false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent" },
-    "azurerm_private_dns_mx_record": {"azurerm_private_dns_mx_record", "pdnsrec", 1, 80,  false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent" },
-    "azurerm_private_dns_ptr_record": {"azurerm_private_dns_ptr_record", "pdnsrec", 1, 80,  false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent" },
-    "azurerm_private_dns_resolver": {"azurerm_private_dns_resolver", "dnspr", 3, 80,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z][a-zA-Z0-9-_]{1,78}[a-zA-Z0-9]$", true, "resourceGroup" },
-    "azurerm_private_dns_resolver_dns_forwarding_ruleset": {"azurerm_private_dns_resolver_dns_forwarding_ruleset", "dnsfwrs", 2, 80,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z][a-zA-Z0-9-_]{0,78}[a-zA-Z0-9]$", true, "resourceGroup" },
-    "azurerm_private_dns_resolver_forwarding_rule": {"azurerm_private_dns_resolver_forwarding_rule", "dnsfwr", 1, 80,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9]([a-zA-Z0-9-_]{0,78}[a-zA-Z0-9])?$", true, "parent" },
-    "azurerm_private_dns_resolver_inbound_endpoint": {"azurerm_private_dns_resolver_inbound_endpoint", "dnsprie", 3, 80,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z][a-zA-Z0-9-_]{1,78}[a-zA-Z0-9]$", true, "parent" },
-    "azurerm_private_dns_resolver_outbound_endpoint": {"azurerm_private_dns_resolver_outbound_endpoint", "dnsproe", 3, 80,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z][a-zA-Z0-9-_]{1,78}[a-zA-Z0-9]$", true, "parent" },
-    "azurerm_private_dns_resolver_virtual_network_link": {"azurerm_private_dns_resolver_virtual_network_link", "dnsfwrsvnetl", 1, 80,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9]([a-zA-Z0-9-_]{0,78}[a-zA-Z0-9])?$", true, "parent" },
-    "azurerm_private_dns_srv_record": {"azurerm_private_dns_srv_record", "pdnsrec", 1, 80,  false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent" },
-    "azurerm_private_dns_txt_record": {"azurerm_private_dns_txt_record", "pdnsrec", 1, 80,  false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent" },
-    "azurerm_private_dns_zone": {"azurerm_private_dns_zone", "pdns", 1, 63,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,61}[a-zA-Z0-9_]$", true, "resourceGroup" },
-    "azurerm_private_dns_zone_group": {"azurerm_private_dns_zone_group", "pdnszg", 1, 80,  false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup" },
-    "azurerm_private_dns_zone_virtual_network_link": {"azurerm_private_dns_zone_virtual_network_link", "pnetlk", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent" },
-    "azurerm_private_endpoint": {"azurerm_private_endpoint", "pe", 2, 64,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,62}[a-zA-Z0-9_]$", true, "resourceGroup" },
-    "azurerm_private_link_service": {"azurerm_private_link_service", "pls", 1, 80,  false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup" },
-    "azurerm_private_service_connection": {"azurerm_private_service_connection", "psc", 1, 80,  false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup" },
-    "azurerm_proximity_placement_group": {"azurerm_proximity_placement_group", "ppg", 1, 80,  false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup" },
-    "azurerm_public_ip": {"azurerm_public_ip", "pip", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent" },
-    "azurerm_public_ip_prefix": {"azurerm_public_ip_prefix", "pippf", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent" },
-    "azurerm_purview_account": {"azurerm_purview_account", "purv", 3, 63,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,61}[a-zA-Z0-9_-]$", true, "subscription" },
-    "azurerm_recovery_services_vault": {"azurerm_recovery_services_vault", "rsv", 2, 50,  false, `[^a-zA-Z0-9-]`, "^[a-zA-Z][a-zA-Z0-9-]{1,49}$", true, "resourceGroup" },
-    "azurerm_recovery_services_vault_backup_police": {"azurerm_recovery_services_vault_backup_police", "rsvbp", 3, 150,  false, `[^a-zA-Z0-9-]`, "^[a-zA-Z][a-zA-Z0-9\\-]{1,148}[a-zA-Z0-9]$", true, "resourceGroup" },
-    "azurerm_redhat_openshift_cluster": {"azurerm_redhat_openshift_cluster", "aroc", 1, 30,  false, "[^0-9A-Za-z]", "^[a-zA-Z0-9]{1,30}$", false, "resourceGroup" },
-    "azurerm_redhat_openshift_domain": {"azurerm_redhat_openshift_domain", "arod", 1, 30,  false, "[^0-9A-Za-z]", "^[a-zA-Z0-9]{1,30}$", false, "resourceGroup" },
-    "azurerm_redis_cache": {"azurerm_redis_cache", "redis", 1, 63,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{0,61}[a-zA-Z0-9]$", true, "global" },
-    "azurerm_redis_firewall_rule": {"azurerm_redis_firewall_rule", "redisfw", 1, 256,  false, "[^0-9A-Za-z]", "^[a-zA-Z0-9]{1,256}$", false, "parent" },
-    "azurerm_relay_hybrid_connection": {"azurerm_relay_hybrid_connection", "rlhc", 1, 260,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,258}[a-zA-Z0-9]$", true, "parent" },
-    "azurerm_relay_namespace": {"azurerm_relay_namespace", "rln", 6, 50,  false, "[^0-9A-Za-z-]", "^[a-zA-Z][a-zA-Z0-9-]{4,48}[a-zA-Z0-9]$", true, "global" },
-    "azurerm_resource_group": {"azurerm_resource_group", "rg", 1, 90,  false, `[^a-zA-Z0-9-._\\(\\)]`, "^[a-zA-Z0-9-._\\(\\)]{0,89}[a-zA-Z0-9-_\\(\\)]$", true, "subscription" },
-    "azurerm_resource_group_policy_assignment": {"azurerm_resource_group_policy_assignment", "argpa", 1, 128,  false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,126}[a-zA-Z0-9_]$", true, "resourceGroup" },
-    "azurerm_role_assignment": {"azurerm_role_assignment", "ra", 1, 64,  false, "[^0-9A-Za-z_.-]", "^[^%]{0,63}[^ %.]$", true, "assignment" },
-    "azurerm_role_definition": {"azurerm_role_definition", "rd", 1, 64,  false, "[^0-9A-Za-z_.-]", "^[^%]{0,63}[^ %.]$", true, "definition" },
-    "azurerm_route": {"azurerm_route", "rt", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent" },
-    "azurerm_route_server": {"azurerm_route_server", "rts", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup" },
-    "azurerm_route_table": {"azurerm_route_table", "route", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup" },
-    "azurerm_search_service": {"azurerm_search_service", "srch", 2, 60,  true, "[a-z0-9-]*--[a-z0-9-]*$", "^[a-z0-9](?:[a-z0-9-]{0,58}[a-z0-9])?$", true, "global" },
-    "azurerm_service_fabric_cluster": {"azurerm_service_fabric_cluster", "sf", 4, 23,  true, "[^0-9a-z-]", "^[a-z][a-z0-9-]{2,21}[a-z0-9]$", true, "region" },
-    "azurerm_servicebus_namespace": {"azurerm_servicebus_namespace", "sb", 6, 50,  false, "[^0-9A-Za-z-]", "^[a-zA-Z][a-zA-Z0-9-]{4,48}[a-zA-Z0-9]$", true, "global" },
-    "azurerm_servicebus_namespace_authorization_rule": {"azurerm_servicebus_namespace_authorization_rule", "sbar", 1, 50,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,48}[a-zA-Z0-9]$", true, "parent" },
-    "azurerm_servicebus_namespace_disaster_recovery_config": {"azurerm_servicebus_namespace_disaster_recovery_config", "sbdr", 1, 50,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,48}[a-zA-Z0-9]$", true, "parent" },
-    "azurerm_servicebus_queue": {"azurerm_servicebus_queue", "sbq", 1, 260,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,258}[a-zA-Z0-9_]$", true, "parent" },
-    "azurerm_servicebus_queue_authorization_rule": {"azurerm_servicebus_queue_authorization_rule", "sbqar", 1, 50,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,48}[a-zA-Z0-9]$", true, "parent" },
-    "azurerm_servicebus_subscription": {"azurerm_servicebus_subscription", "sbs", 1, 50,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,48}[a-zA-Z0-9]$", true, "parent" },
-    "azurerm_servicebus_subscription_rule": {"azurerm_servicebus_subscription_rule", "sbsr", 1, 50,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,48}[a-zA-Z0-9]$", true, "parent" },
-    "azurerm_servicebus_topic": {"azurerm_servicebus_topic", "sbt", 1, 260,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,258}[a-zA-Z0-9]$", true, "parent" },
-    "azurerm_servicebus_topic_authorization_rule": {"azurerm_servicebus_topic_authorization_rule", "sbtar", 1, 50,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,48}[a-zA-Z0-9]$", true, "parent" },
-    "azurerm_shared_image": {"azurerm_shared_image", "si", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "parent" },
-    "azurerm_shared_image_gallery": {"azurerm_shared_image_gallery", "sig", 1, 80,  false, "[^0-9A-Za-z_.]", "^[a-zA-Z0-9][a-zA-Z0-9._]{0,78}[a-zA-Z0-9]$", false, "resourceGroup" },
-    "azurerm_signalr_service": {"azurerm_signalr_service", "sgnlr", 3, 63,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,61}[a-zA-Z0-9]$", true, "global" },
-    "azurerm_snapshots": {"azurerm_snapshots", "snap", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent" },
-    "azurerm_sql_elasticpool": {"azurerm_sql_elasticpool", "sqlep", 1, 128,  false, "[<>*%:.?\\+\\/]", "^[^<>*%:.?\\+\\/]{1,127}[^<>*%:.?\\+\\/ ]$", true, "parent" },
-    "azurerm_sql_failover_group": {"azurerm_sql_failover_group", "sqlfg", 1, 63,  true, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{0,61}[a-z0-9]$", true, "global" },
-    "azurerm_sql_firewall_rule": {"azurerm_sql_firewall_rule", "sqlfw", 1, 128,  false, `[<>*%:?\\+\\/]`, "^[^<>*%:?\\+\\/]{1,127}[^<>*%:.?\\+\\/]$", true, "parent" },
-    "azurerm_sql_server": {"azurerm_sql_server", "sql", 1, 63,  true, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{0,61}[a-z0-9]$", true, "global" },
-    "azurerm_static_site": {"azurerm_static_site", "stapp", 1, 40,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9-]{1,40}$", true, "resourceGroup" },
-    "azurerm_storage_account": {"azurerm_storage_account", "st", 3, 24,  true, "[^0-9a-z]", "^[a-z0-9]{3,24}$", false, "global" },
-    "azurerm_storage_blob": {"azurerm_storage_blob", "blob", 1, 1024,  false, "[\\s\\/$#&]", "^[^\\s\\/$#&]{1,1000}[^\\s\\/$#&]{0,24}$", true, "parent" },
-    "azurerm_storage_container": {"azurerm_storage_container", "stct", 3, 63,  false, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{2,62}$", true, "parent" },
-    "azurerm_storage_data_lake_gen2_filesystem": {"azurerm_storage_data_lake_gen2_filesystem", "stdl", 3, 63,  false, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{1,61}[a-z0-9]$", true, "parent" },
-    "azurerm_storage_queue": {"azurerm_storage_queue", "stq", 3, 63,  false, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{1,61}[a-z0-9]$", true, "parent" },
-    "azurerm_storage_share": {"azurerm_storage_share", "sts", 3, 63,  false, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{1,61}[a-z0-9]$", true, "parent" },
-    "azurerm_storage_share_directory": {"azurerm_storage_share_directory", "sts", 3, 63,  false, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{1,61}[a-z0-9]$", true, "parent" },
-    "azurerm_storage_sync": {"azurerm_storage_sync", "stsy", 1, 260,  false, "[<>*%:.?\\+\\/]", "^[^<>*%:.?\\+\\/]{0,259}[^<>*%:.?\\+\\/ ]$", true, "resourceGroup" },
-    "azurerm_storage_sync_group": {"azurerm_storage_sync_group", "stsg", 1, 260,  false, "[<>*%:.?\\+\\/]", "^[^<>*%:.?\\+\\/]{0,259}[^<>*%:.?\\+\\/ ]$", true, "resourceGroup" },
-    "azurerm_storage_table": {"azurerm_storage_table", "stt", 3, 63,  false, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{1,61}[a-z0-9]$", true, "parent" },
-    "azurerm_stream_analytics_function_javascript_udf": {"azurerm_stream_analytics_function_javascript_udf", "asafunc", 3, 63,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{3,63}$", true, "parent" },
-    "azurerm_stream_analytics_job": {"azurerm_stream_analytics_job", "asa", 3, 63,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{3,63}$", true, "resourceGroup" },
-    "azurerm_stream_analytics_output_blob": {"azurerm_stream_analytics_output_blob", "asaoblob", 3, 63,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{3,63}$", true, "parent" },
-    "azurerm_stream_analytics_output_eventhub": {"azurerm_stream_analytics_output_eventhub", "asaoeh", 3, 63,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{3,63}$", true, "parent" },
-    "azurerm_stream_analytics_output_mssql": {"azurerm_stream_analytics_output_mssql", "asaomssql", 3, 63,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{3,63}$", true, "parent" },
-    "azurerm_stream_analytics_output_servicebus_queue": {"azurerm_stream_analytics_output_servicebus_queue", "asaosbq", 3, 63,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{3,63}$", true, "parent" },
-    "azurerm_stream_analytics_output_servicebus_topic": {"azurerm_stream_analytics_output_servicebus_topic", "asaosbt", 3, 63,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{3,63}$", true, "parent" },
-    "azurerm_stream_analytics_reference_input_blob": {"azurerm_stream_analytics_reference_input_blob", "asarblob", 3, 63,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{3,63}$", true, "parent" },
-    "azurerm_stream_analytics_stream_input_blob": {"azurerm_stream_analytics_stream_input_blob", "asaiblob", 3, 63,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{3,63}$", true, "parent" },
-    "azurerm_stream_analytics_stream_input_eventhub": {"azurerm_stream_analytics_stream_input_eventhub", "asaieh", 3, 63,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{3,63}$", true, "parent" },
-    "azurerm_stream_analytics_stream_input_iothub": {"azurerm_stream_analytics_stream_input_iothub", "asaiiot", 3, 63,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{3,63}$", true, "parent" },
-    "azurerm_subnet": {"azurerm_subnet", "snet", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent" },
-    "azurerm_subscription_policy_assignment": {"azurerm_subscription_policy_assignment", "aspa", 1, 128,  false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,126}[a-zA-Z0-9_]$", true, "subscription" },
-    "azurerm_synapse_firewall_rule": {"azurerm_synapse_firewall_rule", "syfw", 1, 128,  false, `[<>*%:?\\+\\/]`, "^[^<>*%:?\\+\\/]{1,127}[^<>*%:.?\\+\\/]$", true, "parent" },
-    "azurerm_synapse_integration_runtime_azure": {"azurerm_synapse_integration_runtime_azure", "synira", 3, 63,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,61}[a-zA-Z0-9-]$", true, "subscription" },
-    "azurerm_synapse_integration_runtime_self_hosted": {"azurerm_synapse_integration_runtime_self_hosted", "synirsh", 3, 63,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,61}[a-zA-Z0-9_-]$", true, "subscription" },
-    "azurerm_synapse_linked_service": {"azurerm_synapse_linked_service", "synls", 1, 140,  false, "[^0-9A-Za-z_]", "^[a-zA-Z0-9_]{1,140}$", false, "subscription" },
-    "azurerm_synapse_managed_private_endpoint": {"azurerm_synapse_managed_private_endpoint", "synmpe", 3, 63,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9_.-]{1,61}[a-zA-Z0-9_]$", true, "subscription" },
-    "azurerm_synapse_private_link_hub": {"azurerm_synapse_private_link_hub", "synplh", 1, 45,  true, "[^0-9a-z]", "^[a-z0-9]{1,45}$", false, "subscription" },
-    "azurerm_synapse_spark_pool": {"azurerm_synapse_spark_pool", "sysp", 1, 15,  true, "[^0-9a-zA-Z]", "^[0-9a-zA-Z]{1,15}$", false, "parent" },
-    "azurerm_synapse_sql_pool": {"azurerm_synapse_sql_pool", "synsp", 3, 15,  false, "[^0-9A-Za-z_]", "^[a-zA-Z0-9][a-zA-Z0-9_]{1,13}[a-zA-Z0-9]$", false, "subscription" },
-    "azurerm_synapse_sql_pool_vulnerability_assessment_baseline": {"azurerm_synapse_sql_pool_vulnerability_assessment_baseline", "synspvab", 3, 63,  false, "[^0-9A-Za-z]", "^[a-zA-Z0-9]{1,63}$", false, "subscription" },
-    "azurerm_synapse_sql_pool_workload_classifier": {"azurerm_synapse_sql_pool_workload_classifier", "synspwc", 1, 128,  false, `[^-\w\._\(\)]`, "^[^<>*%:?\\+\\/]{1,127}[^<>*%:.?\\+\\/]$", true, "subscription" },
-    "azurerm_synapse_sql_pool_workload_group": {"azurerm_synapse_sql_pool_workload_group", "synspwg", 1, 128,  false, `[^-\w\._\(\)]`, "^[^<>*%:.?\\+\\/]{0,127}[^<>*%:.?\\+\\/ ]$", true, "subscription" },
-    "azurerm_synapse_workspace": {"azurerm_synapse_workspace", "syws", 1, 45,  true, "[^0-9a-z]", "^[0-9a-z]{1,45}$", false, "resourceGroup" },
-    "azurerm_template_deployment": {"azurerm_template_deployment", "deploy", 1, 64,  false, "[^0-9A-Za-z-._\\(\\)]", "^[a-zA-Z0-9-._\\(\\)]{1,64}$", true, "resourceGroup" },
-    "azurerm_traffic_manager_profile": {"azurerm_traffic_manager_profile", "traf", 1, 63,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-.]{0,61}[a-zA-Z0-9_]$", true, "global" },
-    "azurerm_user_assigned_identity": {"azurerm_user_assigned_identity", "msi", 3, 128,  true, "[^0-9a-zA-Z-_]", "^[0-9a-zA-Z][0-9a-zA-Z-_]{2,127}$", true, "parent" },
-    "azurerm_virtual_desktop_application_group": {"azurerm_virtual_desktop_application_group", "dag", 1, 260,  false, "[^0-9A-Za-z _.-]", "^[a-zA-Z0-9 ][a-zA-Z0-9-._ ]{0,258}[a-zA-Z0-9_]$", true, "resourceGroup" },
-    "azurerm_virtual_desktop_host_pool": {"azurerm_virtual_desktop_host_pool", "hpool", 1, 260,  false, "[^0-9A-Za-z _.-]", "^[a-zA-Z0-9 ][a-zA-Z0-9-._ ]{0,258}[a-zA-Z0-9_]$", true, "resourceGroup" },
-    "azurerm_virtual_desktop_workspace": {"azurerm_virtual_desktop_workspace", "wvdws", 1, 260,  false, "[^0-9A-Za-z _.-]", "^[a-zA-Z0-9 ][a-zA-Z0-9-._ ]{0,258}[a-zA-Z0-9_]$", true, "resourceGroup" },
-    "azurerm_virtual_hub": {"azurerm_virtual_hub", "vhub", 1, 50,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,48}[a-zA-Z0-9_]$", true, "parent" },
-    "azurerm_virtual_hub_connection": {"azurerm_virtual_hub_connection", "vhcon", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent" },
-    "azurerm_virtual_machine": {"azurerm_virtual_machine", "vm", 1, 15,  false, "[\\/\"\\[\\]:|<>+=;,?*@&_]", "^[^\\/\"\\[\\]:|<>+=;,?*@&_][^\\/\"\\[\\]:|<>+=;,?*@&]{0,13}[^\\/\"\\[\\]:|<>+=;,?*@&.-]$", true, "resourceGroup" },
-    "azurerm_virtual_machine_extension": {"azurerm_virtual_machine_extension", "vmx", 1, 80,  false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent" },
-    "azurerm_virtual_machine_portal_name": {"azurerm_virtual_machine_portal_name", "vm", 1, 64,  false, "[\\/\"\\[\\]:|<>+=;,?*@&_]", "^[^\\/\"\\[\\]:|<>+=;,?*@&_][^\\/\"\\[\\]:|<>+=;,?*@&]{0,62}[^\\/\"\\[\\]:|<>+=;,?*@&.-]$", true, "resourceGroup" },
-    "azurerm_virtual_machine_scale_set": {"azurerm_virtual_machine_scale_set", "vmss", 1, 15,  false, "[\\/\"\\[\\]:|<>+=;,?*@&_]", "^[^\\/\"\\[\\]:|<>+=;,?*@&_][^\\/\"\\[\\]:|<>+=;,?*@&]{0,13}[^\\/\"\\[\\]:|<>+=;,?*@&.-]$", true, "resourceGroup" },
-    "azurerm_virtual_machine_scale_set_extension": {"azurerm_virtual_machine_scale_set_extension", "vmssx", 1, 80,  false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent" },
-    "azurerm_virtual_network": {"azurerm_virtual_network", "vnet", 2, 64,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,62}[a-zA-Z0-9_]$", true, "resourceGroup" },
-    "azurerm_virtual_network_gateway": {"azurerm_virtual_network_gateway", "vgw", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup" },
-    "azurerm_virtual_network_peering": {"azurerm_virtual_network_peering", "vpeer", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent" },
-    "azurerm_virtual_wan": {"azurerm_virtual_wan", "vwan", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent" },
-    "azurerm_vm_windows_computer_name_prefix": {"azurerm_vm_windows_computer_name_prefix", "cn", 1, 9,  false, "[\\/\"\\[\\]:|<>+=;,?*@&_]", "^[^\\/\"\\[\\]:|<>+=;,?*@&_][^\\/\"\\[\\]:|<>+=;,?*@&]{0,7}[^\\/\"\\[\\]:|<>+=;,?*@&.-]$", true, "resourceGroup" },
-    "azurerm_vmware_cluster": {"azurerm_vmware_cluster", "vwc", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup" },
-    "azurerm_vmware_express_route_authorization": {"azurerm_vmware_express_route_authorization", "vwera", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup" },
-    "azurerm_vmware_private_cloud": {"azurerm_vmware_private_cloud", "vwpc", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup" },
-    "azurerm_vpn_gateway_connection": {"azurerm_vpn_gateway_connection", "vcn", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent" },
-    "azurerm_vpn_site": {"azurerm_vpn_site", "vst", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent" },
-    "azurerm_web_application_firewall_policy": {"azurerm_web_application_firewall_policy", "wafw", 1, 80,  false, "[^0-9A-Za-z]", "^[a-zA-Z0-9][a-zA-Z0-9]{0,78}[a-zA-Z0-9]$", false, "global" },
-    "azurerm_web_pubsub": {"azurerm_web_pubsub", "ps", 3, 63,  false, "[^0-9A-Za-z-]", "^[a-zA-Z][-a-zA-Z0-9]{1,61}[a-zA-Z0-9]$", true, "resourceGroup" },
-    "azurerm_web_pubsub_hub": {"azurerm_web_pubsub_hub", "pshub", 1, 128,  false, "[^0-9A-Za-z_`,.\\[\\]]", "^[a-zA-Z][a-zA-Z0-9_`,.\\[\\]]{0,127}$", false, "parent" },
-    "azurerm_windows_virtual_machine": {"azurerm_windows_virtual_machine", "vm", 1, 15,  false, "[\\/\"\\[\\]:|<>+=;,?*@&_]", "^[^\\/\"\\[\\]:|<>+=;,?*@&_][^\\/\"\\[\\]:|<>+=;,?*@&]{0,13}[^\\/\"\\[\\]:|<>+=;,?*@&.-]$", true, "resourceGroup" },
-    "azurerm_windows_virtual_machine_scale_set": {"azurerm_windows_virtual_machine_scale_set", "vmss", 1, 15,  false, "[\\/\"\\[\\]:|<>+=;,?*@&_]", "^[^\\/\"\\[\\]:|<>+=;,?*@&_][^\\/\"\\[\\]:|<>+=;,?*@&]{0,13}[^\\/\"\\[\\]:|<>+=;,?*@&.-]$", true, "resourceGroup" },
-    "azurerm_windows_web_app": {"azurerm_windows_web_app", "wwapp", 2, 60,  false, "[^0-9A-Za-z-]", "^[0-9A-Za-z][0-9A-Za-z-]{0,58}[0-9a-zA-Z]$", true, "global" },
-    "databricks_cluster": {"databricks_cluster", "dbc", 3, 30,  false, "[^a-zA-Z0-9-_]", "^[a-zA-Z0-9-_]{3,30}$", true, "parent" },
-    "databricks_high_concurrency_cluster": {"databricks_high_concurrency_cluster", "dbhcc", 3, 30,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{3,30}$", true, "parent" },
-    "databricks_standard_cluster": {"databricks_standard_cluster", "dbsc", 3, 30,  false, "[^a-zA-Z0-9-_]", "^[a-zA-Z0-9-_]{3,30}$", true, "parent" },
-    "general": {"general", "", 1, 250,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,250}$", true, "global" },
-    "general_safe": {"general_safe", "", 1, 250,  true, "[^a-z]", "^[a-z]{1,250}$", false, "global" },
+    "aks_node_pool_linux": {"aks_node_pool_linux", "npl", 1, 12,  false, "[^0-9a-z]", "^[a-z][0-9a-z]{0,11}$", false, "parent", "" },
+    "aks_node_pool_windows": {"aks_node_pool_windows", "npw", 1, 6,  false, "[^0-9a-z]", "^[a-z][0-9a-z]{0,5}$", false, "parent", "" },
+    "azurerm_aadb2c_directory": {"azurerm_aadb2c_directory", "aadb2c", 1, 75,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{0,73}[a-zA-Z0-9]$", true, "global", "Microsoft.AzureActiveDirectory/b2cDirectories" },
+    "azurerm_analysis_services_server": {"azurerm_analysis_services_server", "as", 3, 63,  true, "[^0-9a-z]", "^[a-z][a-z0-9]{2,62}$", false, "resourceGroup", "" },
+    "azurerm_api_management": {"azurerm_api_management", "apim", 1, 50,  false, "[^a-zA-Z0-9-]", "^[a-zA-Z][a-zA-Z0-9-]{0,48}[a-zA-Z0-9|]$", true, "global", "Microsoft.ApiManagement/service" },
+    "azurerm_api_management_api": {"azurerm_api_management_api", "apimapi", 1, 80,  false, "[^a-zA-Z0-9-]", "^[a-zA-Z][a-zA-Z0-9-]{0,78}[a-zA-Z0-9|]$", true, "global", "" },
+    "azurerm_api_management_api_operation_tag": {"azurerm_api_management_api_operation_tag", "apimapiopt", 1, 80,  false, "[^a-zA-Z0-9-]", "^[a-zA-Z][a-zA-Z0-9-]{0,78}[a-zA-Z0-9|]$", true, "global", "" },
+    "azurerm_api_management_backend": {"azurerm_api_management_backend", "apimbe", 1, 80,  false, "[^a-zA-Z0-9-]", "^[a-zA-Z][a-zA-Z0-9-]{0,78}[a-zA-Z0-9|]$", true, "global", "" },
+    "azurerm_api_management_certificate": {"azurerm_api_management_certificate", "apimcer", 1, 80,  false, "[^a-zA-Z0-9-]", "^[a-zA-Z][a-zA-Z0-9-]{0,78}[a-zA-Z0-9|]$", true, "global", "" },
+    "azurerm_api_management_gateway": {"azurerm_api_management_gateway", "apimgw", 1, 80,  false, "[^a-zA-Z0-9-]", "^[a-zA-Z][a-zA-Z0-9-]{0,78}[a-zA-Z0-9|]$", true, "global", "" },
+    "azurerm_api_management_group": {"azurerm_api_management_group", "apimgr", 1, 80,  false, "[^a-zA-Z0-9-]", "^[a-zA-Z][a-zA-Z0-9-]{0,78}[a-zA-Z0-9|]$", true, "global", "" },
+    "azurerm_api_management_logger": {"azurerm_api_management_logger", "apimlg", 1, 80,  false, "[^a-zA-Z0-9-]", "^[a-zA-Z][a-zA-Z0-9-]{0,78}[a-zA-Z0-9|]$", true, "global", "" },
+    "azurerm_api_management_service": {"azurerm_api_management_service", "apim", 1, 50,  false, "[^0-9A-Za-z-]", "^[a-z][a-zA-Z0-9-]{0,48}[a-zA-Z0-9]$", true, "global", "Microsoft.ApiManagement/service" },
+    "azurerm_app_configuration": {"azurerm_app_configuration", "appcg", 5, 50,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9-]{5,50}$", true, "resourceGroup", "" },
+    "azurerm_app_service": {"azurerm_app_service", "app", 2, 60,  false, "[^0-9A-Za-z-]", "^[0-9A-Za-z][0-9A-Za-z-]{0,58}[0-9a-zA-Z]$", true, "global", "Microsoft.Web/sites" },
+    "azurerm_app_service_environment": {"azurerm_app_service_environment", "ase", 2, 36,  false, "[^0-9A-Za-z-]", "^[0-9A-Za-z-]{2,36}$", true, "resourceGroup", "Microsoft.Web/hostingEnvironments" },
+    "azurerm_app_service_plan": {"azurerm_app_service_plan", "plan", 1, 40,  false, "[^0-9A-Za-z-]", "^[0-9A-Za-z-]{1,40}$", true, "resourceGroup", "Microsoft.Web/serverfarms" },
+    "azurerm_application_gateway": {"azurerm_application_gateway", "agw", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", "Microsoft.Network/applicationGateways" },
+    "azurerm_application_insights": {"azurerm_application_insights", "appi", 1, 260,  false, "[^0-9A-Za-z_.-]", "^[^%&\\?/. ][^%&\\?/]{0,258}[^%&\\?/. ]$", true, "resourceGroup", "Microsoft.Insights/components" },
+    "azurerm_application_insights_web_test": {"azurerm_application_insights_web_test", "appiwt", 1, 64,  false, "[^0-9A-Za-z- ]", "^[a-zA-Z][a-zA-Z0-9- ]{0,62}[a-zA-Z0-9]$", true, "resourceGroup", "" },
+    "azurerm_application_security_group": {"azurerm_application_security_group", "asg", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", "Microsoft.Network/applicationSecurityGroups" },
+    "azurerm_automation_account": {"azurerm_automation_account", "aa", 6, 50,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z][a-zA-Z0-9-]{4,48}[a-zA-Z0-9]$", true, "resourceGroup", "Microsoft.Automation/automationAccounts" },
+    "azurerm_automation_certificate": {"azurerm_automation_certificate", "aacert", 1, 128,  false, `[^-\w\._\(\)]`, "^[^<>*%:.?\\+\\/]{0,127}[^<>*%:.?\\+\\/ ]$", true, "parent", "" },
+    "azurerm_automation_credential": {"azurerm_automation_credential", "aacred", 1, 128,  false, `[^-\w\._\(\)]`, "^[^<>*%:.?\\+\\/]{0,127}[^<>*%:.?\\+\\/ ]$", true, "parent", "" },
+    "azurerm_automation_hybrid_runbook_worker_group": {"azurerm_automation_hybrid_runbook_worker_group", "aahwg", 1, 128,  false, "[<>*%&:\\?.+/#]", "^([^<>*%&:\\?.+/#\\s]?[ ]?){0,127}[^<>*%&:\\?.+/#\\s]$", true, "parent", "" },
+    "azurerm_automation_job_schedule": {"azurerm_automation_job_schedule", "aajs", 1, 128,  false, `[^-\w\._\(\)]`, "^[^<>*%:.?\\+\\/]{0,127}[^<>*%:.?\\+\\/ ]$", true, "parent", "" },
+    "azurerm_automation_runbook": {"azurerm_automation_runbook", "aarun", 1, 63,  false, "[^0-9A-Za-z_]", "^[a-zA-Z][a-zA-Z0-9-]{0,62}$", true, "parent", "" },
+    "azurerm_automation_schedule": {"azurerm_automation_schedule", "aasched", 1, 128,  false, `[^-\w\._\(\)]`, "^[^<>*%:.?\\+\\/]{0,127}[^<>*%:.?\\+\\/ ]$", true, "parent", "" },
+    "azurerm_automation_variable": {"azurerm_automation_variable", "aavar", 1, 128,  false, `[^-\w\._\(\)]`, "^[^<>*%:.?\\+\\/]{0,127}[^<>*%:.?\\+\\/ ]$", true, "parent", "" },
+    "azurerm_availability_set": {"azurerm_availability_set", "avail", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", "" },
+    "azurerm_bastion_host": {"azurerm_bastion_host", "bast", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent", "" },
+    "azurerm_batch_account": {"azurerm_batch_account", "ba", 3, 24,  true, "[^0-9a-z]", "^[a-z0-9]{3,24}$", false, "region", "" },
+    "azurerm_batch_application": {"azurerm_batch_application", "baapp", 1, 64,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9_-]{1,64}$", true, "parent", "" },
+    "azurerm_batch_certificate": {"azurerm_batch_certificate", "bacert", 5, 45,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9_-]{5,45}$", true, "parent", "" },
+    "azurerm_batch_pool": {"azurerm_batch_pool", "bapool", 3, 24,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9_-]{1,24}$", true, "parent", "" },
+    "azurerm_bot_channel_Email": {"azurerm_bot_channel_Email", "botmail", 2, 64,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{1,63}$", true, "parent", "" },
+    "azurerm_bot_channel_directline": {"azurerm_bot_channel_directline", "botline", 2, 64,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{1,63}$", true, "parent", "" },
+    "azurerm_bot_channel_ms_teams": {"azurerm_bot_channel_ms_teams", "botteams", 2, 64,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{1,63}$", true, "parent", "" },
+    "azurerm_bot_channel_slack": {"azurerm_bot_channel_slack", "botslack", 2, 64,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{1,63}$", true, "parent", "" },
+    "azurerm_bot_channels_registration": {"azurerm_bot_channels_registration", "botchan", 2, 64,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{1,63}$", true, "parent", "" },
+    "azurerm_bot_connection": {"azurerm_bot_connection", "botcon", 2, 64,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{1,63}$", true, "parent", "" },
+    "azurerm_bot_service_azure_bot": {"azurerm_bot_service_azure_bot", "botaz", 2, 64,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{1,63}$", true, "global", "" },
+    "azurerm_bot_web_app": {"azurerm_bot_web_app", "bot", 2, 64,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{1,63}$", true, "global", "" },
+    "azurerm_cdn_endpoint": {"azurerm_cdn_endpoint", "cdn", 1, 50,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{0,48}[a-zA-Z0-9]$", true, "global", "" },
+    "azurerm_cdn_frontdoor_custom_domain": {"azurerm_cdn_frontdoor_custom_domain", "cfdcd", 1, 260,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9]([a-zA-Z0-9-]{0,258}[a-zA-Z0-9])?$", true, "parent", "" },
+    "azurerm_cdn_frontdoor_endpoint": {"azurerm_cdn_frontdoor_endpoint", "cfde", 1, 46,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9]([a-zA-Z0-9-]{0,44}[a-zA-Z0-9])?$", true, "global", "" },
+    "azurerm_cdn_frontdoor_firewall_policy": {"azurerm_cdn_frontdoor_firewall_policy", "cfdfp", 1, 128,  false, "[^0-9A-Za-z]", "^[a-zA-Z][0-9a-zA-Z]{0,127}$", false, "resourceGroup", "" },
+    "azurerm_cdn_frontdoor_origin": {"azurerm_cdn_frontdoor_origin", "cfdo", 1, 90,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9]([a-zA-Z0-9-]{0,88}[a-zA-Z0-9])?$", true, "parent", "" },
+    "azurerm_cdn_frontdoor_origin_group": {"azurerm_cdn_frontdoor_origin_group", "cfdog", 1, 90,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9]([a-zA-Z0-9-]{0,88}[a-zA-Z0-9])?$", true, "parent", "" },
+    "azurerm_cdn_frontdoor_profile": {"azurerm_cdn_frontdoor_profile", "cfdp", 1, 90,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9]([a-zA-Z0-9-]{0,88}[a-zA-Z0-9])?$", true, "resourceGroup", "" },
+    "azurerm_cdn_frontdoor_route": {"azurerm_cdn_frontdoor_route", "cfdroute", 1, 90,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9]([a-zA-Z0-9-]{0,88}[a-zA-Z0-9])?$", true, "parent", "" },
+    "azurerm_cdn_frontdoor_rule": {"azurerm_cdn_frontdoor_rule", "cfdr", 1, 60,  false, "[^0-9A-Za-z]", "^[a-zA-Z][a-zA-Z0-9]{0,59}$", false, "parent", "" },
+    "azurerm_cdn_frontdoor_rule_set": {"azurerm_cdn_frontdoor_rule_set", "cfdrs", 1, 60,  false, "[^0-9A-Za-z]", "^[a-zA-Z][a-zA-Z0-9]{0,59}$", false, "parent", "" },
+    "azurerm_cdn_frontdoor_secret": {"azurerm_cdn_frontdoor_secret", "cfds", 2, 260,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{0,258}[a-zA-Z0-9]$", true, "parent", "" },
+    "azurerm_cdn_frontdoor_security_policy": {"azurerm_cdn_frontdoor_security_policy", "cfdsp", 1, 260,  false, "[^0-9A-Za-z_-]", "^[0-9A-Za-z_-]{1,260}$", true, "parent", "" },
+    "azurerm_cdn_profile": {"azurerm_cdn_profile", "cdnprof", 1, 260,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{0,258}[a-zA-Z0-9]$", true, "resourceGroup", "" },
+    "azurerm_cognitive_account": {"azurerm_cognitive_account", "cog", 2, 64,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{0,63}$", true, "resourceGroup", "" },
+    "azurerm_cognitive_deployment": {"azurerm_cognitive_deployment", "cog", 2, 64,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{0,63}$", true, "resourceGroup", "" },
+    "azurerm_communication_service": {"azurerm_communication_service", "acs", 1, 64,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9_-]{1,64}$", true, "parent", "" },
+    "azurerm_consumption_budget_resource_group": {"azurerm_consumption_budget_resource_group", "acbrg", 1, 63,  false, "[^a-zA-Z0-9-_]", "^[a-zA-Z0-9-_]{1,63}$", true, "resourceGroup", "" },
+    "azurerm_consumption_budget_subscription": {"azurerm_consumption_budget_subscription", "acbs", 1, 63,  false, "[^a-zA-Z0-9-_]", "^[a-zA-Z0-9-_]{1,63}$", true, "subscription", "" },
+    "azurerm_containerGroups": {"azurerm_containerGroups", "cg", 1, 63,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{0,61}[a-zA-Z0-9]$", true, "resourceGroup", "" },
+    "azurerm_container_app": {"azurerm_container_app", "ca", 1, 32,  true, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{0,30}[a-z0-9]$", true, "resourceGroup", "Microsoft.App/containerApps" },
+    "azurerm_container_app_environment": {"azurerm_container_app_environment", "cae", 1, 60,  false, "[^0-9A-Za-z-]", "^[0-9A-Za-z][0-9A-Za-z-]{0,58}[0-9a-zA-Z]$", true, "resourceGroup", "Microsoft.App/managedEnvironments" },
+    "azurerm_container_registry": {"azurerm_container_registry", "cr", 1, 63,  true, "[^0-9A-Za-z]", "^[a-zA-Z0-9]{1,63}$", false, "resourceGroup", "" },
+    "azurerm_container_registry_webhook": {"azurerm_container_registry_webhook", "crwh", 1, 50,  false, "[^0-9A-Za-z]", "^[a-zA-Z0-9]{1,50}$", false, "resourceGroup", "" },
+    "azurerm_cosmosdb_account": {"azurerm_cosmosdb_account", "cosmos", 1, 44,  true, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{0,42}[a-z0-9]$", true, "resourceGroup", "Microsoft.DocumentDB/databaseAccounts" },
+    "azurerm_custom_provider": {"azurerm_custom_provider", "prov", 3, 64,  false, "[&%.?\\/]", "^[^&%?\\/]{2,63}[^&%.?\\/ ]$", true, "resourceGroup", "" },
+    "azurerm_dashboard": {"azurerm_dashboard", "dsb", 3, 160,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9-]{3,160}$", true, "parent", "" },
+    "azurerm_data_factory": {"azurerm_data_factory", "adf", 3, 63,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,61}[a-zA-Z0-9]$", true, "global", "Microsoft.DataFactory/factories" },
+    "azurerm_data_factory_dataset_azure_blob": {"azurerm_data_factory_dataset_azure_blob", "adfblob", 1, 260,  false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,258}[a-zA-Z0-9]$", true, "parent", "" },
+    "azurerm_data_factory_dataset_cosmosdb_sqlapi": {"azurerm_data_factory_dataset_cosmosdb_sqlapi", "adfsqlapi", 1, 260,  false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,258}[a-zA-Z0-9]$", true, "parent", "" },
+    "azurerm_data_factory_dataset_delimited_text": {"azurerm_data_factory_dataset_delimited_text", "adfdtext", 1, 260,  false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,258}[a-zA-Z0-9]$", true, "parent", "" },
+    "azurerm_data_factory_dataset_http": {"azurerm_data_factory_dataset_http", "adfhttp", 1, 260,  false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,258}[a-zA-Z0-9]$", true, "parent", "" },
+    "azurerm_data_factory_dataset_json": {"azurerm_data_factory_dataset_json", "adfjson", 1, 260,  false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,258}[a-zA-Z0-9]$", true, "parent", "" },
+    "azurerm_data_factory_dataset_mysql": {"azurerm_data_factory_dataset_mysql", "adfmysql", 1, 260,  false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,258}[a-zA-Z0-9]$", true, "parent", "" },
+    "azurerm_data_factory_dataset_postgresql": {"azurerm_data_factory_dataset_postgresql", "adfpsql", 1, 260,  false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,258}[a-zA-Z0-9]$", true, "parent", "" },
+    "azurerm_data_factory_dataset_sql_server_table": {"azurerm_data_factory_dataset_sql_server_table", "adfmssql", 1, 260,  false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,258}[a-zA-Z0-9]$", true, "parent", "" },
+    "azurerm_data_factory_integration_runtime_managed": {"azurerm_data_factory_integration_runtime_managed", "adfir", 3, 63,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,61}[a-zA-Z0-9]$", true, "parent", "" },
+    "azurerm_data_factory_linked_service_azure_blob_storage": {"azurerm_data_factory_linked_service_azure_blob_storage", "adflsabs", 1, 260,  false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,259}$", true, "parent", "" },
+    "azurerm_data_factory_linked_service_azure_databricks": {"azurerm_data_factory_linked_service_azure_databricks", "adflsadb", 1, 260,  false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,259}$", true, "parent", "" },
+    "azurerm_data_factory_linked_service_azure_function": {"azurerm_data_factory_linked_service_azure_function", "adflsaf", 1, 260,  false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,259}$", true, "parent", "" },
+    "azurerm_data_factory_linked_service_azure_sql_database": {"azurerm_data_factory_linked_service_azure_sql_database", "adflsasdb", 1, 260,  false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,259}$", true, "parent", "" },
+    "azurerm_data_factory_linked_service_cosmosdb": {"azurerm_data_factory_linked_service_cosmosdb", "adflsacdb", 1, 260,  false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,259}$", true, "parent", "" },
+    "azurerm_data_factory_linked_service_data_lake_storage_gen2": {"azurerm_data_factory_linked_service_data_lake_storage_gen2", "adfsvst", 1, 260,  false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,259}$", true, "parent", "" },
+    "azurerm_data_factory_linked_service_key_vault": {"azurerm_data_factory_linked_service_key_vault", "adfsvkv", 1, 260,  false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,259}$", true, "parent", "" },
+    "azurerm_data_factory_linked_service_mysql": {"azurerm_data_factory_linked_service_mysql", "adfsvmysql", 1, 260,  false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,259}$", true, "parent", "" },
+    "azurerm_data_factory_linked_service_postgresql": {"azurerm_data_factory_linked_service_postgresql", "adfsvpsql", 1, 260,  false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,259}$", true, "parent", "" },
+    "azurerm_data_factory_linked_service_sftp": {"azurerm_data_factory_linked_service_sftp", "adflsaftp", 1, 260,  false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,259}$", true, "parent", "" },
+    "azurerm_data_factory_linked_service_sql_server": {"azurerm_data_factory_linked_service_sql_server", "adfsvmssql", 1, 260,  false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,259}$", true, "parent", "" },
+    "azurerm_data_factory_linked_service_web": {"azurerm_data_factory_linked_service_web", "adfsvweb", 1, 260,  false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,259}$", true, "parent", "" },
+    "azurerm_data_factory_pipeline": {"azurerm_data_factory_pipeline", "adfpl", 1, 260,  false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,258}[a-zA-Z0-9]$", true, "parent", "" },
+    "azurerm_data_factory_trigger_schedule": {"azurerm_data_factory_trigger_schedule", "adftg", 1, 260,  false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,259}$", true, "parent", "" },
+    "azurerm_data_lake_analytics_account": {"azurerm_data_lake_analytics_account", "dla", 3, 24,  false, "[^0-9a-z]", "^[a-z0-9]{3,24}$", false, "global", "" },
+    "azurerm_data_lake_analytics_firewall_rule": {"azurerm_data_lake_analytics_firewall_rule", "dlfw", 3, 50,  false, "[^0-9a-z_-]", "^[a-z0-9-_]{3,50}$", true, "parent", "" },
+    "azurerm_data_lake_store": {"azurerm_data_lake_store", "dls", 3, 24,  false, "[^0-9a-z]", "^[a-z0-9]{3,24}$", false, "parent", "Microsoft.Storage/storageAccounts" },
+    "azurerm_data_lake_store_firewall_rule": {"azurerm_data_lake_store_firewall_rule", "dlsfw", 3, 50,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{3,50}$", true, "parent", "" },
+    "azurerm_data_protection_backup_policy_blob_storage": {"azurerm_data_protection_backup_policy_blob_storage", "dpbpb", 3, 150,  false, `[^a-zA-Z0-9-]`, "^[a-zA-Z][a-zA-Z0-9\\-]{1,148}[a-zA-Z0-9]$", true, "resourceGroup", "" },
+    "azurerm_data_protection_backup_policy_disk": {"azurerm_data_protection_backup_policy_disk", "dpbpd", 3, 150,  false, `[^a-zA-Z0-9-]`, "^[a-zA-Z][a-zA-Z0-9\\-]{1,148}[a-zA-Z0-9]$", true, "resourceGroup", "" },
+    "azurerm_data_protection_backup_policy_postgresql": {"azurerm_data_protection_backup_policy_postgresql", "dpbpp", 3, 150,  false, `[^a-zA-Z0-9-]`, "^[a-zA-Z][a-zA-Z0-9\\-]{1,148}[a-zA-Z0-9]$", true, "resourceGroup", "" },
+    "azurerm_data_protection_backup_policy_postgresql_flexible_server": {"azurerm_data_protection_backup_policy_postgresql_flexible_server", "dpbppf", 3, 150,  false, `[^a-zA-Z0-9-]`, "^[a-zA-Z][a-zA-Z0-9\\-]{1,148}[a-zA-Z0-9]$", true, "resourceGroup", "" },
+    "azurerm_data_protection_backup_vault": {"azurerm_data_protection_backup_vault", "dpbv", 2, 50,  false, `[^a-zA-Z0-9-]`, "^[a-zA-Z][a-zA-Z0-9-]{1,49}$", true, "resourceGroup", "" },
+    "azurerm_database_migration_project": {"azurerm_database_migration_project", "migr", 2, 57,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{1,56}$", true, "parent", "" },
+    "azurerm_database_migration_service": {"azurerm_database_migration_service", "dms", 2, 62,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{1,61}$", true, "resourceGroup", "Microsoft.DataMigration/services" },
+    "azurerm_databricks_workspace": {"azurerm_databricks_workspace", "dbw", 3, 64,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{3,64}$", true, "resourceGroup", "" },
+    "azurerm_dedicated_host": {"azurerm_dedicated_host", "dh", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", "" },
+    "azurerm_dedicated_host_group": {"azurerm_dedicated_host_group", "dhg", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", "" },
+    "azurerm_dev_center": {"azurerm_dev_center", "dc", 3, 26,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,24}[a-zA-Z0-9]$", true, "resourceGroup", "" },
+    "azurerm_dev_center_catalog": {"azurerm_dev_center_catalog", "dcc", 3, 63,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,61}[a-zA-Z0-9]$", true, "parent", "" },
+    "azurerm_dev_center_dev_box_definition": {"azurerm_dev_center_dev_box_definition", "dcdb", 3, 63,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,61}[a-zA-Z0-9]$", true, "parent", "" },
+    "azurerm_dev_center_environment_type": {"azurerm_dev_center_environment_type", "dcet", 3, 63,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,61}[a-zA-Z0-9]$", true, "parent", "" },
+    "azurerm_dev_center_gallery": {"azurerm_dev_center_gallery", "dcg", 1, 80,  false, "[^0-9A-Za-z_.]", "^[a-zA-Z0-9]([a-zA-Z0-9_.]{0,78}[a-zA-Z0-9])?$", false, "parent", "" },
+    "azurerm_dev_center_network_connection": {"azurerm_dev_center_network_connection", "dcnc", 3, 63,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,61}[a-zA-Z0-9]$", true, "resourceGroup", "" },
+    "azurerm_dev_center_project": {"azurerm_dev_center_project", "dcp", 3, 63,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,61}[a-zA-Z0-9]$", true, "resourceGroup", "" },
+    "azurerm_dev_center_project_environment_type": {"azurerm_dev_center_project_environment_type", "dcpet", 3, 63,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,61}[a-zA-Z0-9]$", true, "parent", "" },
+    "azurerm_dev_test_lab": {"azurerm_dev_test_lab", "lab", 1, 50,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,50}$", true, "resourceGroup", "" },
+    "azurerm_dev_test_linux_virtual_machine": {"azurerm_dev_test_linux_virtual_machine", "labvm", 1, 64,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9-]{1,64}$", true, "parent", "" },
+    "azurerm_dev_test_windows_virtual_machine": {"azurerm_dev_test_windows_virtual_machine", "labvm", 1, 15,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9-]{1,15}$", true, "parent", "" },
+    "azurerm_digital_twins_endpoint_eventgrid": {"azurerm_digital_twins_endpoint_eventgrid", "adteg", 3, 50,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9_-]{1,50}$", true, "parent", "" },
+    "azurerm_digital_twins_endpoint_eventhub": {"azurerm_digital_twins_endpoint_eventhub", "adteh", 3, 50,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9_-]{1,50}$", true, "parent", "" },
+    "azurerm_digital_twins_endpoint_servicebus": {"azurerm_digital_twins_endpoint_servicebus", "adtsb", 3, 50,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9_-]{1,50}$", true, "parent", "" },
+    "azurerm_digital_twins_instance": {"azurerm_digital_twins_instance", "adt", 4, 63,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9_-]{1,63}$", true, "subscription", "Microsoft.DigitalTwins/digitalTwinsInstances" },
+    "azurerm_disk_encryption_set": {"azurerm_disk_encryption_set", "des", 1, 80,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,80}$", true, "resourceGroup", "" },
+    "azurerm_dns_a_record": {"azurerm_dns_a_record", "dnsrec", 1, 80,  false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent", "" },
+    "azurerm_dns_aaaa_record": {"azurerm_dns_aaaa_record", "dnsrec", 1, 80,  false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent", "" },
+    "azurerm_dns_caa_record": {"azurerm_dns_caa_record", "dnsrec", 1, 80,  false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent", "" },
+    "azurerm_dns_cname_record": {"azurerm_dns_cname_record", "dnsrec", 1, 80,  false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent", "" },
+    "azurerm_dns_mx_record": {"azurerm_dns_mx_record", "dnsrec", 1, 80,  false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent", "" },
+    "azurerm_dns_ns_record": {"azurerm_dns_ns_record", "dnsrec", 1, 80,  false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent", "" },
+    "azurerm_dns_ptr_record": {"azurerm_dns_ptr_record", "dnsrec", 1, 80,  false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent", "" },
+    "azurerm_dns_txt_record": {"azurerm_dns_txt_record", "dnsrec", 1, 80,  false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent", "" },
+    "azurerm_dns_zone": {"azurerm_dns_zone", "dns", 1, 63,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,61}[a-zA-Z0-9_]$", true, "resourceGroup", "Microsoft.Network/privateDnsZones" },
+    "azurerm_eventgrid_domain": {"azurerm_eventgrid_domain", "egd", 3, 50,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9-]{3,50}$", true, "resourceGroup", "" },
+    "azurerm_eventgrid_domain_topic": {"azurerm_eventgrid_domain_topic", "egdt", 3, 50,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9-]{3,50}$", true, "parent", "" },
+    "azurerm_eventgrid_event_subscription": {"azurerm_eventgrid_event_subscription", "egs", 3, 64,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9-]{3,64}$", true, "resourceGroup", "" },
+    "azurerm_eventgrid_topic": {"azurerm_eventgrid_topic", "egt", 3, 50,  false, `[^0-9A-Za-z-]`, "^[a-zA-Z0-9-]{3,50}$", true, "resourceGroup", "" },
+    "azurerm_eventhub": {"azurerm_eventhub", "evh", 1, 50,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,48}[a-zA-Z0-9]$", true, "parent", "" },
+    "azurerm_eventhub_authorization_rule": {"azurerm_eventhub_authorization_rule", "ehar", 1, 50,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,48}[a-zA-Z0-9]$", true, "parent", "" },
+    "azurerm_eventhub_consumer_group": {"azurerm_eventhub_consumer_group", "ehcg", 1, 50,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,48}[a-zA-Z0-9]$", true, "parent", "" },
+    "azurerm_eventhub_namespace": {"azurerm_eventhub_namespace", "ehn", 1, 50,  false, "[^0-9A-Za-z-]", "^[a-zA-Z][a-zA-Z0-9-]{0,48}[a-zA-Z0-9]$", true, "global", "Microsoft.EventHub/namespaces/eventhubs" },
+    "azurerm_eventhub_namespace_authorization_rule": {"azurerm_eventhub_namespace_authorization_rule", "ehnar", 1, 50,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,48}[a-zA-Z0-9]$", true, "parent", "" },
+    "azurerm_eventhub_namespace_disaster_recovery_config": {"azurerm_eventhub_namespace_disaster_recovery_config", "ehdr", 1, 50,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,48}[a-zA-Z0-9]$", true, "parent", "" },
+    "azurerm_express_route_circuit": {"azurerm_express_route_circuit", "erc", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", "" },
+    "azurerm_express_route_gateway": {"azurerm_express_route_gateway", "ergw", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", "" },
+    "azurerm_federated_identity_credential": {"azurerm_federated_identity_credential", "fedcred", 3, 120,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9][a-zA-Z0-9_-]{2,119}$", true, "parent", "" },
+    "azurerm_firewall": {"azurerm_firewall", "fw", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", "Microsoft.Network/azureFirewalls" },
+    "azurerm_firewall_application_rule_collection": {"azurerm_firewall_application_rule_collection", "fwapp", 1, 80,  false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent", "" },
+    "azurerm_firewall_ip_configuration": {"azurerm_firewall_ip_configuration", "fwipconf", 1, 80,  false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", "" },
+    "azurerm_firewall_nat_rule_collection": {"azurerm_firewall_nat_rule_collection", "fwnatrc", 1, 80,  false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent", "" },
+    "azurerm_firewall_network_rule_collection": {"azurerm_firewall_network_rule_collection", "fwnetrc", 1, 80,  false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent", "" },
+    "azurerm_firewall_policy": {"azurerm_firewall_policy", "afwp", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", "" },
+    "azurerm_frontdoor": {"azurerm_frontdoor", "fd", 5, 64,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{3,62}[a-zA-Z0-9]$", true, "global", "Microsoft.Network/frontDoors" },
+    "azurerm_frontdoor_firewall_policy": {"azurerm_frontdoor_firewall_policy", "fdfw", 1, 80,  false, "[^0-9A-Za-z]", "^[a-zA-Z0-9][a-zA-Z0-9]{0,78}[a-zA-Z0-9]$", false, "global", "" },
+    "azurerm_function_app": {"azurerm_function_app", "fa", 2, 60,  false, "[^0-9A-Za-z-]", "^[0-9A-Za-z][0-9A-Za-z-]{0,58}[0-9a-zA-Z]$", true, "global", "" },
+    "azurerm_function_app_slot": {"azurerm_function_app_slot", "fas", 2, 59,  false, "[^0-9A-Za-z-]", "^[0-9A-Za-z][0-9A-Za-z-]{0,57}[0-9a-zA-Z]$", true, "global", "" },
+    "azurerm_hdinsight_hadoop_cluster": {"azurerm_hdinsight_hadoop_cluster", "hadoop", 3, 59,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,57}[a-zA-Z0-9]$", true, "global", "" },
+    "azurerm_hdinsight_hbase_cluster": {"azurerm_hdinsight_hbase_cluster", "hbase", 3, 59,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,57}[a-zA-Z0-9]$", true, "global", "" },
+    "azurerm_hdinsight_interactive_query_cluster": {"azurerm_hdinsight_interactive_query_cluster", "iqr", 3, 59,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,57}[a-zA-Z0-9]$", true, "global", "" },
+    "azurerm_hdinsight_kafka_cluster": {"azurerm_hdinsight_kafka_cluster", "kafka", 3, 59,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,57}[a-zA-Z0-9]$", true, "global", "" },
+    "azurerm_hdinsight_ml_services_cluster": {"azurerm_hdinsight_ml_services_cluster", "mls", 3, 59,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,57}[a-zA-Z0-9]$", true, "global", "" },
+    "azurerm_hdinsight_rserver_cluster": {"azurerm_hdinsight_rserver_cluster", "rser", 3, 59,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,57}[a-zA-Z0-9]$", true, "global", "" },
+    "azurerm_hdinsight_spark_cluster": {"azurerm_hdinsight_spark_cluster", "spark", 3, 59,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,57}[a-zA-Z0-9]$", true, "global", "" },
+    "azurerm_hdinsight_storm_cluster": {"azurerm_hdinsight_storm_cluster", "storm", 3, 59,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,57}[a-zA-Z0-9]$", true, "global", "" },
+    "azurerm_healthcare_dicom_service": {"azurerm_healthcare_dicom_service", "dicom", 3, 24,  true, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{1,22}[a-z0-9]$", true, "parent", "" },
+    "azurerm_healthcare_fhir_service": {"azurerm_healthcare_fhir_service", "fhir", 3, 24,  true, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{1,22}[a-z0-9]$", true, "parent", "" },
+    "azurerm_healthcare_medtech_service": {"azurerm_healthcare_medtech_service", "medtech", 3, 24,  true, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{1,22}[a-z0-9]$", true, "parent", "" },
+    "azurerm_healthcare_service": {"azurerm_healthcare_service", "hcasvc", 3, 24,  true, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{1,22}[a-z0-9]$", true, "global", "" },
+    "azurerm_healthcare_workspace": {"azurerm_healthcare_workspace", "hcw", 3, 24,  true, "[^0-9a-z]", "^[a-z0-9]{3,24}$", false, "global", "" },
+    "azurerm_image": {"azurerm_image", "img", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", "" },
+    "azurerm_integration_service_environment": {"azurerm_integration_service_environment", "lappise", 1, 80,  false, "[^0-9A-Za-z\\-\\_\\.]", "^[0-9A-Za-z\\-\\_\\.]{1,80}$", true, "resourceGroup", "" },
+    "azurerm_iot_security_device_group": {"azurerm_iot_security_device_group", "iotdg", 1, 32,  false, "[^0-9A-Za-z-._]", "^[a-zA-Z0-9-._]{1,32}$", true, "parent", "" },
+    "azurerm_iot_security_solution": {"azurerm_iot_security_solution", "iotss", 1, 260,  false, "[^0-9A-Za-z-_]", "^[a-zA-Z0-9-_]{1,260}$", true, "resourceGroup", "" },
+    "azurerm_iotcentral_application": {"azurerm_iotcentral_application", "iotapp", 2, 63,  true, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{0,61}[a-z0-9]$", true, "global", "" },
+    "azurerm_iothub": {"azurerm_iothub", "iot", 3, 50,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,48}[a-z0-9]$", true, "global", "Microsoft.Devices/IotHubs" },
+    "azurerm_iothub_certificate": {"azurerm_iothub_certificate", "iotcert", 1, 64,  false, "[^0-9A-Za-z-._]", "^[a-zA-Z0-9-._]{1,64}$", true, "parent", "" },
+    "azurerm_iothub_consumer_group": {"azurerm_iothub_consumer_group", "iotcg", 1, 50,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9-._]{1,50}$", true, "parent", "" },
+    "azurerm_iothub_dps": {"azurerm_iothub_dps", "dps", 3, 64,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9-]{1,63}[a-zA-Z0-9]$", true, "resourceGroup", "Microsoft.DataMigration/services" },
+    "azurerm_iothub_dps_certificate": {"azurerm_iothub_dps_certificate", "dpscert", 1, 64,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9-._]{1,64}$", true, "parent", "" },
+    "azurerm_iothub_dps_shared_access_policy": {"azurerm_iothub_dps_shared_access_policy", "dpssap", 1, 64,  false, "[^0-9A-Za-z-._]", "^[a-zA-Z0-9-._]{1,64}$", true, "parent", "" },
+    "azurerm_iothub_shared_access_policy": {"azurerm_iothub_shared_access_policy", "iotsap", 1, 64,  false, "[^0-9A-Za-z-._]", "^[a-zA-Z0-9-._]{1,64}$", true, "parent", "" },
+    "azurerm_ip_group": {"azurerm_ip_group", "ipgr", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", "" },
+    "azurerm_key_vault": {"azurerm_key_vault", "kv", 3, 24,  false, "[^0-9A-Za-z-]", "^[a-zA-Z][a-zA-Z0-9-]{1,22}[a-zA-Z0-9]$", true, "global", "Microsoft.KeyVault/vaults" },
+    "azurerm_key_vault_certificate": {"azurerm_key_vault_certificate", "kvc", 1, 127,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9-]{1,127}$", true, "parent", "" },
+    "azurerm_key_vault_key": {"azurerm_key_vault_key", "kvk", 1, 127,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9-]{1,127}$", true, "parent", "" },
+    "azurerm_key_vault_secret": {"azurerm_key_vault_secret", "kvs", 1, 127,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9-]{1,127}$", true, "parent", "" },
+    "azurerm_kubernetes_cluster": {"azurerm_kubernetes_cluster", "aks", 1, 63,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9][a-zA-Z0-9-_]{0,61}[a-zA-Z0-9]$", true, "resourceGroup", "Microsoft.ContainerService/managedClusters" },
+    "azurerm_kubernetes_fleet_manager": {"azurerm_kubernetes_fleet_manager", "fleet", 1, 63,  true, "[^0-9a-z-]", "^[0-9a-z]([0-9a-z-]{0,61}[0-9a-z])?$", true, "resourceGroup", "" },
+    "azurerm_kusto_cluster": {"azurerm_kusto_cluster", "kc", 4, 22,  false, "[^0-9A-Za-z]", "^[a-z][a-z0-9]{3,21}$", false, "global", "" },
+    "azurerm_kusto_database": {"azurerm_kusto_database", "kdb", 1, 260,  false, "[^0-9A-Za-z- .]", "^[a-zA-Z0-9- .]{1,260}$", true, "parent", "" },
+    "azurerm_kusto_eventhub_data_connection": {"azurerm_kusto_eventhub_data_connection", "kehc", 1, 40,  false, "[^0-9A-Za-z- .]", "^[a-zA-Z0-9- .]{1,40}$", true, "parent", "" },
+    "azurerm_lb": {"azurerm_lb", "lb", 1, 80,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", "Microsoft.Network/loadBalancers" },
+    "azurerm_lb_backend_address_pool": {"azurerm_lb_backend_address_pool", "adt", 4, 63,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9_-]{1,63}$", true, "subscription", "Microsoft.DigitalTwins/digitalTwinsInstances" },
+    "azurerm_lb_backend_pool": {"azurerm_lb_backend_pool", "adt", 4, 63,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9_-]{1,63}$", true, "subscription", "Microsoft.DigitalTwins/digitalTwinsInstances" },
+    "azurerm_lb_nat_pool": {"azurerm_lb_nat_pool", "adt", 4, 63,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9_-]{1,63}$", true, "subscription", "Microsoft.DigitalTwins/digitalTwinsInstances" },
+    "azurerm_lb_nat_rule": {"azurerm_lb_nat_rule", "lbnatrl", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent", "" },
+    "azurerm_lb_outbound_rule": {"azurerm_lb_outbound_rule", "adt", 4, 63,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9_-]{1,63}$", true, "subscription", "Microsoft.DigitalTwins/digitalTwinsInstances" },
+    "azurerm_lb_probe": {"azurerm_lb_probe", "adt", 4, 63,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9_-]{1,63}$", true, "subscription", "Microsoft.DigitalTwins/digitalTwinsInstances" },
+    "azurerm_lb_rule": {"azurerm_lb_rule", "adt", 4, 63,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9_-]{1,63}$", true, "subscription", "Microsoft.DigitalTwins/digitalTwinsInstances" },
+    "azurerm_linux_virtual_machine": {"azurerm_linux_virtual_machine", "vm", 1, 64,  false, "[\\/\"\\[\\]:|<>+=;,?*@&_]", "^[^\\/\"\\[\\]:|<>+=;,?*@&_][^\\/\"\\[\\]:|<>+=;,?*@&]{0,62}[^\\/\"\\[\\]:|<>+=;,?*@&.-]$", true, "resourceGroup", "Microsoft.Compute/virtualMachines" },
+    "azurerm_linux_virtual_machine_scale_set": {"azurerm_linux_virtual_machine_scale_set", "vmss", 1, 64,  false, "[\\/\"\\[\\]:|<>+=;,?*@&_]", "^[^\\/\"\\[\\]:|<>+=;,?*@&_][^\\/\"\\[\\]:|<>+=;,?*@&]{0,62}[^\\/\"\\[\\]:|<>+=;,?*@&.-]$", true, "resourceGroup", "Microsoft.Compute/virtualMachineScaleSets" },
+    "azurerm_linux_web_app": {"azurerm_linux_web_app", "lwapp", 2, 60,  false, "[^0-9A-Za-z-]", "^[0-9A-Za-z][0-9A-Za-z-]{0,58}[0-9a-zA-Z]$", true, "global", "" },
+    "azurerm_load_test": {"azurerm_load_test", "load", 1, 64,  false, "[^0-9A-Za-z-_]", "^[a-zA-Z][a-zA-Z0-9-_]{0,62}[a-zA-Z0-9|]$", true, "global", "" },
+    "azurerm_local_network_gateway": {"azurerm_local_network_gateway", "lgw", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", "Microsoft.Network/localNetworkGateways" },
+    "azurerm_log_analytics_cluster": {"azurerm_log_analytics_cluster", "logc", 4, 63,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{2,61}[a-zA-Z0-9]$", true, "resourceGroup", "" },
+    "azurerm_log_analytics_query_pack": {"azurerm_log_analytics_query_pack", "laqp", 4, 63,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{2,61}[a-zA-Z0-9]$", true, "parent", "" },
+    "azurerm_log_analytics_solution": {"azurerm_log_analytics_solution", "las", 4, 63,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{2,61}[a-zA-Z0-9]$", true, "parent", "" },
+    "azurerm_log_analytics_storage_insights": {"azurerm_log_analytics_storage_insights", "lasi", 4, 63,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{2,61}[a-zA-Z0-9]$", true, "parent", "" },
+    "azurerm_log_analytics_workspace": {"azurerm_log_analytics_workspace", "log", 4, 63,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{2,61}[a-zA-Z0-9]$", true, "parent", "Microsoft.OperationalInsights/workspaces" },
+    "azurerm_logic_app_action_custom": {"azurerm_logic_app_action_custom", "lappac", 1, 80,  false, "[^0-9A-Za-z\\(\\-\\)\\_\\.]", "^[0-9A-Za-z\\(\\-\\)\\_\\.]{1,80}$", true, "resourceGroup", "" },
+    "azurerm_logic_app_action_http": {"azurerm_logic_app_action_http", "lappah", 1, 80,  false, "[^0-9A-Za-z\\(\\-\\)\\_\\.]", "^[0-9A-Za-z\\(\\-\\)\\_\\.]{1,80}$", true, "resourceGroup", "" },
+    "azurerm_logic_app_integration_account": {"azurerm_logic_app_integration_account", "lappia", 1, 80,  false, "[^0-9A-Za-z\\(\\-\\)\\_\\.]", "^[0-9A-Za-z\\(\\-\\)\\_\\.]{1,80}$", true, "resourceGroup", "" },
+    "azurerm_logic_app_trigger_custom": {"azurerm_logic_app_trigger_custom", "lapptc", 1, 80,  false, "[^0-9A-Za-z\\(\\-\\)\\_\\.]", "^[0-9A-Za-z\\(\\-\\)\\_\\.]{1,80}$", true, "resourceGroup", "" },
+    "azurerm_logic_app_trigger_http_request": {"azurerm_logic_app_trigger_http_request", "lappth", 1, 80,  false, "[^0-9A-Za-z\\(\\-\\)\\_\\.]", "^[0-9A-Za-z\\(\\-\\)\\_\\.]{1,80}$", true, "resourceGroup", "" },
+    "azurerm_logic_app_trigger_recurrence": {"azurerm_logic_app_trigger_recurrence", "lapptc", 1, 80,  false, "[^0-9A-Za-z\\(\\-\\)\\_\\.]", "^[0-9A-Za-z\\(\\-\\)\\_\\.]{1,80}$", true, "resourceGroup", "" },
+    "azurerm_logic_app_workflow": {"azurerm_logic_app_workflow", "lapp", 1, 80,  false, "[^0-9A-Za-z\\(\\-\\)\\_\\.]", "^[0-9A-Za-z\\(\\-\\)\\_\\.]{1,80}$", true, "resourceGroup", "" },
+    "azurerm_machine_learning_compute_instance": {"azurerm_machine_learning_compute_instance", "amlci", 1, 16,  false, "[^0-9a-z-]", "^[a-zA-Z0-9][a-z0-9-]{0,14}[a-zA-Z0-9]$", true, "parent", "" },
+    "azurerm_machine_learning_workspace": {"azurerm_machine_learning_workspace", "mlw", 1, 260,  false, "[<>*%:.?\\+\\/]", "^[^<>*%:.?\\+\\/]{0,259}[^<>*%:.?\\+\\/ ]$", true, "resourceGroup", "" },
+    "azurerm_maintenance_configuration": {"azurerm_maintenance_configuration", "mcf", 1, 60,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,58}[a-zA-Z0-9_]$", true, "resourceGroup", "" },
+    "azurerm_managed_disk": {"azurerm_managed_disk", "dsk", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", "Microsoft.Compute/disks" },
+    "azurerm_maps_account": {"azurerm_maps_account", "map", 1, 98,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,97}$", true, "resourceGroup", "" },
+    "azurerm_mariadb_database": {"azurerm_mariadb_database", "mariadb", 1, 63,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,63}$", true, "parent", "" },
+    "azurerm_mariadb_firewall_rule": {"azurerm_mariadb_firewall_rule", "mariafw", 1, 128,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,128}$", true, "parent", "" },
+    "azurerm_mariadb_server": {"azurerm_mariadb_server", "maria", 3, 63,  false, "[^0-9A-Za-z-]", "^[a-z0-9][a-zA-Z0-9-]{1,61}[a-z0-9]$", true, "global", "" },
+    "azurerm_mariadb_virtual_network_rule": {"azurerm_mariadb_virtual_network_rule", "mariavn", 1, 128,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,128}$", true, "parent", "" },
+    "azurerm_monitor_action_group": {"azurerm_monitor_action_group", "amag", 1, 260,  false, `[^~!@$^*()\[\]\{\}_\-="';,0-9A-Za-z _.-]`, "^[^|:<>+#%&\\?/]{0,259}[^|:<>+#%&\\?/. ]$", true, "resourceGroup", "" },
+    "azurerm_monitor_activity_log_alert": {"azurerm_monitor_activity_log_alert", "adfmysql", 1, 260,  false, "[^0-9A-Za-z<>*%:&?#\\+\\/]", "^[^<>*%:&?#\\+\\/]{0,259}[^<>*%:&.?#\\+\\/]$", true, "parent", "" },
+    "azurerm_monitor_autoscale_setting": {"azurerm_monitor_autoscale_setting", "amas", 2, 64,  false, "[^0-9A-Za-z _.-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{0,62}[a-zA-Z0-9]$", true, "resourceGroup", "" },
+    "azurerm_monitor_data_collection_endpoint": {"azurerm_monitor_data_collection_endpoint", "dce", 3, 44,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,42}[a-zA-Z0-9]$", true, "resourceGroup", "" },
+    "azurerm_monitor_data_collection_rule": {"azurerm_monitor_data_collection_rule", "dcr", 3, 44,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,42}[a-zA-Z0-9]$", true, "resourceGroup", "" },
+    "azurerm_monitor_diagnostic_setting": {"azurerm_monitor_diagnostic_setting", "amds", 1, 260,  false, "[^0-9A-Za-z _.-]", "^[a-zA-Z0-9 ][a-zA-Z0-9-._ ]{0,258}[a-zA-Z0-9_]$", true, "parent", "" },
+    "azurerm_monitor_metric_alert": {"azurerm_monitor_metric_alert", "ma", 1, 251,  false, "[<>*%&:\\?+/#@{}]", "^[^<>*%&:\\?+/#@{}]{0,250}[^<>*%&:\\?+/#@{}. ]$", true, "resourceGroup", "" },
+    "azurerm_monitor_private_link_scope": {"azurerm_monitor_private_link_scope", "ampls", 1, 255,  false, "[^0-9A-Za-z-._()]", "^[0-9A-Za-z-._()]{0,254}[0-9A-Za-z-_()]$", true, "resourceGroup", "" },
+    "azurerm_monitor_scheduled_query_rules_alert": {"azurerm_monitor_scheduled_query_rules_alert", "schqra", 1, 260,  false, "[<>*%&:\\?/#{}]", "^[^<>*%&:\\?/#{}]{0,259}[^<>*%&:\\?/#{}. ]$", true, "resourceGroup", "" },
+    "azurerm_mssql_database": {"azurerm_mssql_database", "sqldb", 1, 128,  false, "[<>*%:.?\\+\\/ ]", "^[^<>*%:.?\\+\\/]{1,127}[^<>*%:.?\\+\\/ ]$", true, "parent", "Microsoft.Sql/servers/databases" },
+    "azurerm_mssql_elasticpool": {"azurerm_mssql_elasticpool", "sqlep", 1, 128,  false, "[<>*%:.?\\+\\/]", "^[^<>*%:.?\\+\\/]{1,127}[^<>*%:.?\\+\\/ ]$", true, "parent", "" },
+    "azurerm_mssql_mi": {"azurerm_mssql_mi", "sqlmi", 1, 63,  true, "[^0-9A-Za-z-]", "^[a-z0-9][a-z0-9-]{0,61}[a-z0-9]$", true, "global", "" },
+    "azurerm_mssql_server": {"azurerm_mssql_server", "sql", 1, 63,  true, "[^0-9A-Za-z-]", "^[a-z0-9][a-z0-9-]{0,61}[a-z0-9]$", true, "global", "Microsoft.Sql/servers" },
+    "azurerm_mysql_database": {"azurerm_mysql_database", "mysqldb", 1, 63,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,63}$", true, "parent", "" },
+    "azurerm_mysql_firewall_rule": {"azurerm_mysql_firewall_rule", "mysqlfw", 1, 128,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,128}$", true, "parent", "" },
+    "azurerm_mysql_flexible_server": {"azurerm_mysql_flexible_server", "mysqlf", 3, 63,  false, "[^0-9A-Za-z-]", "^[a-z0-9][a-zA-Z0-9-]{1,61}[a-z0-9]$", true, "global", "" },
+    "azurerm_mysql_flexible_server_database": {"azurerm_mysql_flexible_server_database", "mysqlfdb", 1, 63,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,63}$", true, "parent", "" },
+    "azurerm_mysql_flexible_server_firewall_rule": {"azurerm_mysql_flexible_server_firewall_rule", "mysqlffw", 1, 128,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,128}$", true, "parent", "" },
+    "azurerm_mysql_server": {"azurerm_mysql_server", "mysql", 3, 63,  false, "[^0-9A-Za-z-]", "^[a-z0-9][a-zA-Z0-9-]{1,61}[a-z0-9]$", true, "global", "Microsoft.DBforMySQL/servers" },
+    "azurerm_mysql_virtual_network_rule": {"azurerm_mysql_virtual_network_rule", "mysqlvn", 1, 128,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,128}$", true, "parent", "" },
+    "azurerm_netapp_account": {"azurerm_netapp_account", "ana", 1, 128,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9][a-zA-Z0-9-_]{0,126}[a-zA-Z0-9]$", true, "resourceGroup", "" },
+    "azurerm_netapp_pool": {"azurerm_netapp_pool", "anp", 1, 63,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9][a-zA-Z0-9-_]{0,61}[a-zA-Z0-9]$", true, "resourceGroup", "" },
+    "azurerm_netapp_snapshot": {"azurerm_netapp_snapshot", "ans", 1, 63,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9][a-zA-Z0-9-_]{0,61}[a-zA-Z0-9]$", true, "resourceGroup", "" },
+    "azurerm_netapp_volume": {"azurerm_netapp_volume", "anv", 1, 63,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9][a-zA-Z0-9-_]{0,61}[a-zA-Z0-9]$", true, "resourceGroup", "" },
+    "azurerm_network_ddos_protection_plan": {"azurerm_network_ddos_protection_plan", "ddospp", 1, 80,  false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent", "" },
+    "azurerm_network_interface": {"azurerm_network_interface", "nic", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", "Microsoft.Network/networkInterfaces" },
+    "azurerm_network_security_group": {"azurerm_network_security_group", "nsg", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", "Microsoft.Network/networkSecurityGroups" },
+    "azurerm_network_security_group_rule": {"azurerm_network_security_group_rule", "nsgr", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent", "" },
+    "azurerm_network_security_rule": {"azurerm_network_security_rule", "nsgr", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent", "" },
+    "azurerm_network_watcher": {"azurerm_network_watcher", "nw", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", "" },
+    "azurerm_nginx_deployment": {"azurerm_nginx_deployment", "nginx", 1, 30,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9]([a-zA-Z0-9-]{0,28}[a-zA-Z0-9])?$", true, "resourceGroup", "" },
+    "azurerm_notification_hub": {"azurerm_notification_hub", "nh", 1, 260,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,259}$", true, "parent", "" },
+    "azurerm_notification_hub_authorization_rule": {"azurerm_notification_hub_authorization_rule", "dnsrec", 1, 256,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,255}$", true, "parent", "" },
+    "azurerm_notification_hub_namespace": {"azurerm_notification_hub_namespace", "dnsrec", 6, 50,  false, "[^0-9A-Za-z-]", "^[a-zA-Z][a-zA-Z0-9-]{4,48}[a-zA-Z0-9]$", true, "global", "" },
+    "azurerm_point_to_site_vpn_gateway": {"azurerm_point_to_site_vpn_gateway", "vpngw", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", "" },
+    "azurerm_portal_dashboard": {"azurerm_portal_dashboard", "dsb", 3, 160,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9-]{3,160}$", true, "parent", "" },
+    "azurerm_postgresql_database": {"azurerm_postgresql_database", "psqldb", 1, 63,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,63}$", true, "parent", "" },
+    "azurerm_postgresql_firewall_rule": {"azurerm_postgresql_firewall_rule", "psqlfw", 1, 128,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,128}$", true, "parent", "" },
+    "azurerm_postgresql_flexible_server": {"azurerm_postgresql_flexible_server", "psqlf", 3, 63,  true, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{1,61}[a-z0-9]$", true, "global", "" },
+    "azurerm_postgresql_flexible_server_database": {"azurerm_postgresql_flexible_server_database", "psqlfdb", 1, 63,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,63}$", true, "parent", "" },
+    "azurerm_postgresql_flexible_server_firewall_rule": {"azurerm_postgresql_flexible_server_firewall_rule", "psqlffw", 1, 128,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,128}$", true, "parent", "" },
+    "azurerm_postgresql_server": {"azurerm_postgresql_server", "psql", 3, 63,  false, "[^0-9A-Za-z-]", "^[a-z0-9][a-zA-Z0-9-]{1,61}[a-z0-9]$", true, "global", "Microsoft.DBforPostgreSQL/servers" },
+    "azurerm_postgresql_virtual_network_rule": {"azurerm_postgresql_virtual_network_rule", "psqlvn", 1, 128,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,128}$", true, "parent", "" },
+    "azurerm_powerbi_embedded": {"azurerm_powerbi_embedded", "pbi", 3, 63,  false, "[^0-9a-z]", "^[a-z0-9][a-z0-9]{2,62}$", false, "region", "" },
+    "azurerm_private_dns_a_record": {"azurerm_private_dns_a_record", "pdnsrec", 1, 80,  false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent", "" },
+    "azurerm_private_dns_aaaa_record": {"azurerm_private_dns_aaaa_record", "pdnsrec", 1, 80,  false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent", "" },
+    "azurerm_private_dns_cname_record": {"azurerm_private_dns_cname_record", "pdnsrec", 1, 80,  false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent", "" },
+    "azurerm_private_dns_mx_record": {"azurerm_private_dns_mx_record", "pdnsrec", 1, 80,  false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent", "" },
+    "azurerm_private_dns_ptr_record": {"azurerm_private_dns_ptr_record", "pdnsrec", 1, 80,  false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent", "" },
+    "azurerm_private_dns_resolver": {"azurerm_private_dns_resolver", "dnspr", 3, 80,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z][a-zA-Z0-9-_]{1,78}[a-zA-Z0-9]$", true, "resourceGroup", "" },
+    "azurerm_private_dns_resolver_dns_forwarding_ruleset": {"azurerm_private_dns_resolver_dns_forwarding_ruleset", "dnsfwrs", 2, 80,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z][a-zA-Z0-9-_]{0,78}[a-zA-Z0-9]$", true, "resourceGroup", "" },
+    "azurerm_private_dns_resolver_forwarding_rule": {"azurerm_private_dns_resolver_forwarding_rule", "dnsfwr", 1, 80,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9]([a-zA-Z0-9-_]{0,78}[a-zA-Z0-9])?$", true, "parent", "" },
+    "azurerm_private_dns_resolver_inbound_endpoint": {"azurerm_private_dns_resolver_inbound_endpoint", "dnsprie", 3, 80,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z][a-zA-Z0-9-_]{1,78}[a-zA-Z0-9]$", true, "parent", "" },
+    "azurerm_private_dns_resolver_outbound_endpoint": {"azurerm_private_dns_resolver_outbound_endpoint", "dnsproe", 3, 80,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z][a-zA-Z0-9-_]{1,78}[a-zA-Z0-9]$", true, "parent", "" },
+    "azurerm_private_dns_resolver_virtual_network_link": {"azurerm_private_dns_resolver_virtual_network_link", "dnsfwrsvnetl", 1, 80,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9]([a-zA-Z0-9-_]{0,78}[a-zA-Z0-9])?$", true, "parent", "" },
+    "azurerm_private_dns_srv_record": {"azurerm_private_dns_srv_record", "pdnsrec", 1, 80,  false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent", "" },
+    "azurerm_private_dns_txt_record": {"azurerm_private_dns_txt_record", "pdnsrec", 1, 80,  false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent", "" },
+    "azurerm_private_dns_zone": {"azurerm_private_dns_zone", "pdns", 1, 63,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,61}[a-zA-Z0-9_]$", true, "resourceGroup", "" },
+    "azurerm_private_dns_zone_group": {"azurerm_private_dns_zone_group", "pdnszg", 1, 80,  false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", "" },
+    "azurerm_private_dns_zone_virtual_network_link": {"azurerm_private_dns_zone_virtual_network_link", "pnetlk", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent", "" },
+    "azurerm_private_endpoint": {"azurerm_private_endpoint", "pe", 2, 64,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,62}[a-zA-Z0-9_]$", true, "resourceGroup", "" },
+    "azurerm_private_link_service": {"azurerm_private_link_service", "pls", 1, 80,  false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", "" },
+    "azurerm_private_service_connection": {"azurerm_private_service_connection", "psc", 1, 80,  false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", "" },
+    "azurerm_proximity_placement_group": {"azurerm_proximity_placement_group", "ppg", 1, 80,  false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", "" },
+    "azurerm_public_ip": {"azurerm_public_ip", "pip", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent", "Microsoft.Network/publicIPAddresses" },
+    "azurerm_public_ip_prefix": {"azurerm_public_ip_prefix", "pippf", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent", "" },
+    "azurerm_purview_account": {"azurerm_purview_account", "purv", 3, 63,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,61}[a-zA-Z0-9_-]$", true, "subscription", "" },
+    "azurerm_recovery_services_vault": {"azurerm_recovery_services_vault", "rsv", 2, 50,  false, `[^a-zA-Z0-9-]`, "^[a-zA-Z][a-zA-Z0-9-]{1,49}$", true, "resourceGroup", "" },
+    "azurerm_recovery_services_vault_backup_police": {"azurerm_recovery_services_vault_backup_police", "rsvbp", 3, 150,  false, `[^a-zA-Z0-9-]`, "^[a-zA-Z][a-zA-Z0-9\\-]{1,148}[a-zA-Z0-9]$", true, "resourceGroup", "" },
+    "azurerm_redhat_openshift_cluster": {"azurerm_redhat_openshift_cluster", "aroc", 1, 30,  false, "[^0-9A-Za-z]", "^[a-zA-Z0-9]{1,30}$", false, "resourceGroup", "" },
+    "azurerm_redhat_openshift_domain": {"azurerm_redhat_openshift_domain", "arod", 1, 30,  false, "[^0-9A-Za-z]", "^[a-zA-Z0-9]{1,30}$", false, "resourceGroup", "" },
+    "azurerm_redis_cache": {"azurerm_redis_cache", "redis", 1, 63,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{0,61}[a-zA-Z0-9]$", true, "global", "Microsoft.Cache/Redis" },
+    "azurerm_redis_firewall_rule": {"azurerm_redis_firewall_rule", "redisfw", 1, 256,  false, "[^0-9A-Za-z]", "^[a-zA-Z0-9]{1,256}$", false, "parent", "" },
+    "azurerm_relay_hybrid_connection": {"azurerm_relay_hybrid_connection", "rlhc", 1, 260,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,258}[a-zA-Z0-9]$", true, "parent", "" },
+    "azurerm_relay_namespace": {"azurerm_relay_namespace", "rln", 6, 50,  false, "[^0-9A-Za-z-]", "^[a-zA-Z][a-zA-Z0-9-]{4,48}[a-zA-Z0-9]$", true, "global", "" },
+    "azurerm_resource_group": {"azurerm_resource_group", "rg", 1, 90,  false, `[^a-zA-Z0-9-._\\(\\)]`, "^[a-zA-Z0-9-._\\(\\)]{0,89}[a-zA-Z0-9-_\\(\\)]$", true, "subscription", "Microsoft.Resources/resourceGroups" },
+    "azurerm_resource_group_policy_assignment": {"azurerm_resource_group_policy_assignment", "argpa", 1, 128,  false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,126}[a-zA-Z0-9_]$", true, "resourceGroup", "" },
+    "azurerm_role_assignment": {"azurerm_role_assignment", "ra", 1, 64,  false, "[^0-9A-Za-z_.-]", "^[^%]{0,63}[^ %.]$", true, "assignment", "" },
+    "azurerm_role_definition": {"azurerm_role_definition", "rd", 1, 64,  false, "[^0-9A-Za-z_.-]", "^[^%]{0,63}[^ %.]$", true, "definition", "" },
+    "azurerm_route": {"azurerm_route", "rt", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent", "Microsoft.Network/routeTables" },
+    "azurerm_route_server": {"azurerm_route_server", "rts", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", "" },
+    "azurerm_route_table": {"azurerm_route_table", "route", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", "" },
+    "azurerm_search_service": {"azurerm_search_service", "srch", 2, 60,  true, "[a-z0-9-]*--[a-z0-9-]*$", "^[a-z0-9](?:[a-z0-9-]{0,58}[a-z0-9])?$", true, "global", "Microsoft.Search/searchServices" },
+    "azurerm_service_fabric_cluster": {"azurerm_service_fabric_cluster", "sf", 4, 23,  true, "[^0-9a-z-]", "^[a-z][a-z0-9-]{2,21}[a-z0-9]$", true, "region", "Microsoft.ServiceFabric/clusters" },
+    "azurerm_servicebus_namespace": {"azurerm_servicebus_namespace", "sb", 6, 50,  false, "[^0-9A-Za-z-]", "^[a-zA-Z][a-zA-Z0-9-]{4,48}[a-zA-Z0-9]$", true, "global", "Microsoft.ServiceBus/namespaces" },
+    "azurerm_servicebus_namespace_authorization_rule": {"azurerm_servicebus_namespace_authorization_rule", "sbar", 1, 50,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,48}[a-zA-Z0-9]$", true, "parent", "" },
+    "azurerm_servicebus_namespace_disaster_recovery_config": {"azurerm_servicebus_namespace_disaster_recovery_config", "sbdr", 1, 50,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,48}[a-zA-Z0-9]$", true, "parent", "" },
+    "azurerm_servicebus_queue": {"azurerm_servicebus_queue", "sbq", 1, 260,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,258}[a-zA-Z0-9_]$", true, "parent", "Microsoft.ServiceBus/namespaces/queues" },
+    "azurerm_servicebus_queue_authorization_rule": {"azurerm_servicebus_queue_authorization_rule", "sbqar", 1, 50,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,48}[a-zA-Z0-9]$", true, "parent", "" },
+    "azurerm_servicebus_subscription": {"azurerm_servicebus_subscription", "sbs", 1, 50,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,48}[a-zA-Z0-9]$", true, "parent", "" },
+    "azurerm_servicebus_subscription_rule": {"azurerm_servicebus_subscription_rule", "sbsr", 1, 50,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,48}[a-zA-Z0-9]$", true, "parent", "" },
+    "azurerm_servicebus_topic": {"azurerm_servicebus_topic", "sbt", 1, 260,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,258}[a-zA-Z0-9]$", true, "parent", "Microsoft.ServiceBus/namespaces/topics" },
+    "azurerm_servicebus_topic_authorization_rule": {"azurerm_servicebus_topic_authorization_rule", "sbtar", 1, 50,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,48}[a-zA-Z0-9]$", true, "parent", "" },
+    "azurerm_shared_image": {"azurerm_shared_image", "si", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "parent", "" },
+    "azurerm_shared_image_gallery": {"azurerm_shared_image_gallery", "sig", 1, 80,  false, "[^0-9A-Za-z_.]", "^[a-zA-Z0-9][a-zA-Z0-9._]{0,78}[a-zA-Z0-9]$", false, "resourceGroup", "" },
+    "azurerm_signalr_service": {"azurerm_signalr_service", "sgnlr", 3, 63,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,61}[a-zA-Z0-9]$", true, "global", "" },
+    "azurerm_snapshots": {"azurerm_snapshots", "snap", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent", "Microsoft.Compute/snapshots" },
+    "azurerm_sql_elasticpool": {"azurerm_sql_elasticpool", "sqlep", 1, 128,  false, "[<>*%:.?\\+\\/]", "^[^<>*%:.?\\+\\/]{1,127}[^<>*%:.?\\+\\/ ]$", true, "parent", "" },
+    "azurerm_sql_failover_group": {"azurerm_sql_failover_group", "sqlfg", 1, 63,  true, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{0,61}[a-z0-9]$", true, "global", "" },
+    "azurerm_sql_firewall_rule": {"azurerm_sql_firewall_rule", "sqlfw", 1, 128,  false, `[<>*%:?\\+\\/]`, "^[^<>*%:?\\+\\/]{1,127}[^<>*%:.?\\+\\/]$", true, "parent", "" },
+    "azurerm_sql_server": {"azurerm_sql_server", "sql", 1, 63,  true, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{0,61}[a-z0-9]$", true, "global", "Microsoft.Sql/servers" },
+    "azurerm_static_site": {"azurerm_static_site", "stapp", 1, 40,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9-]{1,40}$", true, "resourceGroup", "" },
+    "azurerm_storage_account": {"azurerm_storage_account", "st", 3, 24,  true, "[^0-9a-z]", "^[a-z0-9]{3,24}$", false, "global", "Microsoft.Storage/storageAccounts" },
+    "azurerm_storage_blob": {"azurerm_storage_blob", "blob", 1, 1024,  false, "[\\s\\/$#&]", "^[^\\s\\/$#&]{1,1000}[^\\s\\/$#&]{0,24}$", true, "parent", "" },
+    "azurerm_storage_container": {"azurerm_storage_container", "stct", 3, 63,  false, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{2,62}$", true, "parent", "" },
+    "azurerm_storage_data_lake_gen2_filesystem": {"azurerm_storage_data_lake_gen2_filesystem", "stdl", 3, 63,  false, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{1,61}[a-z0-9]$", true, "parent", "" },
+    "azurerm_storage_queue": {"azurerm_storage_queue", "stq", 3, 63,  false, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{1,61}[a-z0-9]$", true, "parent", "" },
+    "azurerm_storage_share": {"azurerm_storage_share", "sts", 3, 63,  false, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{1,61}[a-z0-9]$", true, "parent", "" },
+    "azurerm_storage_share_directory": {"azurerm_storage_share_directory", "sts", 3, 63,  false, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{1,61}[a-z0-9]$", true, "parent", "" },
+    "azurerm_storage_sync": {"azurerm_storage_sync", "stsy", 1, 260,  false, "[<>*%:.?\\+\\/]", "^[^<>*%:.?\\+\\/]{0,259}[^<>*%:.?\\+\\/ ]$", true, "resourceGroup", "" },
+    "azurerm_storage_sync_group": {"azurerm_storage_sync_group", "stsg", 1, 260,  false, "[<>*%:.?\\+\\/]", "^[^<>*%:.?\\+\\/]{0,259}[^<>*%:.?\\+\\/ ]$", true, "resourceGroup", "" },
+    "azurerm_storage_table": {"azurerm_storage_table", "stt", 3, 63,  false, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{1,61}[a-z0-9]$", true, "parent", "" },
+    "azurerm_stream_analytics_function_javascript_udf": {"azurerm_stream_analytics_function_javascript_udf", "asafunc", 3, 63,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{3,63}$", true, "parent", "" },
+    "azurerm_stream_analytics_job": {"azurerm_stream_analytics_job", "asa", 3, 63,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{3,63}$", true, "resourceGroup", "Microsoft.StreamAnalytics/streamingjobs" },
+    "azurerm_stream_analytics_output_blob": {"azurerm_stream_analytics_output_blob", "asaoblob", 3, 63,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{3,63}$", true, "parent", "" },
+    "azurerm_stream_analytics_output_eventhub": {"azurerm_stream_analytics_output_eventhub", "asaoeh", 3, 63,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{3,63}$", true, "parent", "" },
+    "azurerm_stream_analytics_output_mssql": {"azurerm_stream_analytics_output_mssql", "asaomssql", 3, 63,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{3,63}$", true, "parent", "" },
+    "azurerm_stream_analytics_output_servicebus_queue": {"azurerm_stream_analytics_output_servicebus_queue", "asaosbq", 3, 63,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{3,63}$", true, "parent", "" },
+    "azurerm_stream_analytics_output_servicebus_topic": {"azurerm_stream_analytics_output_servicebus_topic", "asaosbt", 3, 63,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{3,63}$", true, "parent", "" },
+    "azurerm_stream_analytics_reference_input_blob": {"azurerm_stream_analytics_reference_input_blob", "asarblob", 3, 63,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{3,63}$", true, "parent", "" },
+    "azurerm_stream_analytics_stream_input_blob": {"azurerm_stream_analytics_stream_input_blob", "asaiblob", 3, 63,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{3,63}$", true, "parent", "" },
+    "azurerm_stream_analytics_stream_input_eventhub": {"azurerm_stream_analytics_stream_input_eventhub", "asaieh", 3, 63,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{3,63}$", true, "parent", "" },
+    "azurerm_stream_analytics_stream_input_iothub": {"azurerm_stream_analytics_stream_input_iothub", "asaiiot", 3, 63,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{3,63}$", true, "parent", "" },
+    "azurerm_subnet": {"azurerm_subnet", "snet", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent", "Microsoft.Network/virtualNetworks/subnets" },
+    "azurerm_subscription_policy_assignment": {"azurerm_subscription_policy_assignment", "aspa", 1, 128,  false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,126}[a-zA-Z0-9_]$", true, "subscription", "" },
+    "azurerm_synapse_firewall_rule": {"azurerm_synapse_firewall_rule", "syfw", 1, 128,  false, `[<>*%:?\\+\\/]`, "^[^<>*%:?\\+\\/]{1,127}[^<>*%:.?\\+\\/]$", true, "parent", "" },
+    "azurerm_synapse_integration_runtime_azure": {"azurerm_synapse_integration_runtime_azure", "synira", 3, 63,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,61}[a-zA-Z0-9-]$", true, "subscription", "" },
+    "azurerm_synapse_integration_runtime_self_hosted": {"azurerm_synapse_integration_runtime_self_hosted", "synirsh", 3, 63,  false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,61}[a-zA-Z0-9_-]$", true, "subscription", "" },
+    "azurerm_synapse_linked_service": {"azurerm_synapse_linked_service", "synls", 1, 140,  false, "[^0-9A-Za-z_]", "^[a-zA-Z0-9_]{1,140}$", false, "subscription", "" },
+    "azurerm_synapse_managed_private_endpoint": {"azurerm_synapse_managed_private_endpoint", "synmpe", 3, 63,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9_.-]{1,61}[a-zA-Z0-9_]$", true, "subscription", "" },
+    "azurerm_synapse_private_link_hub": {"azurerm_synapse_private_link_hub", "synplh", 1, 45,  true, "[^0-9a-z]", "^[a-z0-9]{1,45}$", false, "subscription", "" },
+    "azurerm_synapse_spark_pool": {"azurerm_synapse_spark_pool", "sysp", 1, 15,  true, "[^0-9a-zA-Z]", "^[0-9a-zA-Z]{1,15}$", false, "parent", "" },
+    "azurerm_synapse_sql_pool": {"azurerm_synapse_sql_pool", "synsp", 3, 15,  false, "[^0-9A-Za-z_]", "^[a-zA-Z0-9][a-zA-Z0-9_]{1,13}[a-zA-Z0-9]$", false, "subscription", "" },
+    "azurerm_synapse_sql_pool_vulnerability_assessment_baseline": {"azurerm_synapse_sql_pool_vulnerability_assessment_baseline", "synspvab", 3, 63,  false, "[^0-9A-Za-z]", "^[a-zA-Z0-9]{1,63}$", false, "subscription", "" },
+    "azurerm_synapse_sql_pool_workload_classifier": {"azurerm_synapse_sql_pool_workload_classifier", "synspwc", 1, 128,  false, `[^-\w\._\(\)]`, "^[^<>*%:?\\+\\/]{1,127}[^<>*%:.?\\+\\/]$", true, "subscription", "" },
+    "azurerm_synapse_sql_pool_workload_group": {"azurerm_synapse_sql_pool_workload_group", "synspwg", 1, 128,  false, `[^-\w\._\(\)]`, "^[^<>*%:.?\\+\\/]{0,127}[^<>*%:.?\\+\\/ ]$", true, "subscription", "" },
+    "azurerm_synapse_workspace": {"azurerm_synapse_workspace", "syws", 1, 45,  true, "[^0-9a-z]", "^[0-9a-z]{1,45}$", false, "resourceGroup", "" },
+    "azurerm_template_deployment": {"azurerm_template_deployment", "deploy", 1, 64,  false, "[^0-9A-Za-z-._\\(\\)]", "^[a-zA-Z0-9-._\\(\\)]{1,64}$", true, "resourceGroup", "" },
+    "azurerm_traffic_manager_profile": {"azurerm_traffic_manager_profile", "traf", 1, 63,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-.]{0,61}[a-zA-Z0-9_]$", true, "global", "" },
+    "azurerm_user_assigned_identity": {"azurerm_user_assigned_identity", "msi", 3, 128,  true, "[^0-9a-zA-Z-_]", "^[0-9a-zA-Z][0-9a-zA-Z-_]{2,127}$", true, "parent", "" },
+    "azurerm_virtual_desktop_application_group": {"azurerm_virtual_desktop_application_group", "dag", 1, 260,  false, "[^0-9A-Za-z _.-]", "^[a-zA-Z0-9 ][a-zA-Z0-9-._ ]{0,258}[a-zA-Z0-9_]$", true, "resourceGroup", "" },
+    "azurerm_virtual_desktop_host_pool": {"azurerm_virtual_desktop_host_pool", "hpool", 1, 260,  false, "[^0-9A-Za-z _.-]", "^[a-zA-Z0-9 ][a-zA-Z0-9-._ ]{0,258}[a-zA-Z0-9_]$", true, "resourceGroup", "" },
+    "azurerm_virtual_desktop_workspace": {"azurerm_virtual_desktop_workspace", "wvdws", 1, 260,  false, "[^0-9A-Za-z _.-]", "^[a-zA-Z0-9 ][a-zA-Z0-9-._ ]{0,258}[a-zA-Z0-9_]$", true, "resourceGroup", "" },
+    "azurerm_virtual_hub": {"azurerm_virtual_hub", "vhub", 1, 50,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,48}[a-zA-Z0-9_]$", true, "parent", "" },
+    "azurerm_virtual_hub_connection": {"azurerm_virtual_hub_connection", "vhcon", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent", "" },
+    "azurerm_virtual_machine": {"azurerm_virtual_machine", "vm", 1, 15,  false, "[\\/\"\\[\\]:|<>+=;,?*@&_]", "^[^\\/\"\\[\\]:|<>+=;,?*@&_][^\\/\"\\[\\]:|<>+=;,?*@&]{0,13}[^\\/\"\\[\\]:|<>+=;,?*@&.-]$", true, "resourceGroup", "Microsoft.Compute/virtualMachines" },
+    "azurerm_virtual_machine_extension": {"azurerm_virtual_machine_extension", "vmx", 1, 80,  false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent", "" },
+    "azurerm_virtual_machine_portal_name": {"azurerm_virtual_machine_portal_name", "vm", 1, 64,  false, "[\\/\"\\[\\]:|<>+=;,?*@&_]", "^[^\\/\"\\[\\]:|<>+=;,?*@&_][^\\/\"\\[\\]:|<>+=;,?*@&]{0,62}[^\\/\"\\[\\]:|<>+=;,?*@&.-]$", true, "resourceGroup", "Microsoft.Compute/virtualMachines" },
+    "azurerm_virtual_machine_scale_set": {"azurerm_virtual_machine_scale_set", "vmss", 1, 15,  false, "[\\/\"\\[\\]:|<>+=;,?*@&_]", "^[^\\/\"\\[\\]:|<>+=;,?*@&_][^\\/\"\\[\\]:|<>+=;,?*@&]{0,13}[^\\/\"\\[\\]:|<>+=;,?*@&.-]$", true, "resourceGroup", "Microsoft.Compute/virtualMachineScaleSets" },
+    "azurerm_virtual_machine_scale_set_extension": {"azurerm_virtual_machine_scale_set_extension", "vmssx", 1, 80,  false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent", "" },
+    "azurerm_virtual_network": {"azurerm_virtual_network", "vnet", 2, 64,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,62}[a-zA-Z0-9_]$", true, "resourceGroup", "Microsoft.Network/virtualNetworks" },
+    "azurerm_virtual_network_gateway": {"azurerm_virtual_network_gateway", "vgw", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", "Microsoft.Network/virtualNetworkGateways" },
+    "azurerm_virtual_network_peering": {"azurerm_virtual_network_peering", "vpeer", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent", "" },
+    "azurerm_virtual_wan": {"azurerm_virtual_wan", "vwan", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent", "" },
+    "azurerm_vm_windows_computer_name_prefix": {"azurerm_vm_windows_computer_name_prefix", "cn", 1, 9,  false, "[\\/\"\\[\\]:|<>+=;,?*@&_]", "^[^\\/\"\\[\\]:|<>+=;,?*@&_][^\\/\"\\[\\]:|<>+=;,?*@&]{0,7}[^\\/\"\\[\\]:|<>+=;,?*@&.-]$", true, "resourceGroup", "Microsoft.Network/connections" },
+    "azurerm_vmware_cluster": {"azurerm_vmware_cluster", "vwc", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", "" },
+    "azurerm_vmware_express_route_authorization": {"azurerm_vmware_express_route_authorization", "vwera", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", "" },
+    "azurerm_vmware_private_cloud": {"azurerm_vmware_private_cloud", "vwpc", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", "" },
+    "azurerm_vpn_gateway_connection": {"azurerm_vpn_gateway_connection", "vcn", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent", "" },
+    "azurerm_vpn_site": {"azurerm_vpn_site", "vst", 1, 80,  false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent", "" },
+    "azurerm_web_application_firewall_policy": {"azurerm_web_application_firewall_policy", "wafw", 1, 80,  false, "[^0-9A-Za-z]", "^[a-zA-Z0-9][a-zA-Z0-9]{0,78}[a-zA-Z0-9]$", false, "global", "" },
+    "azurerm_web_pubsub": {"azurerm_web_pubsub", "ps", 3, 63,  false, "[^0-9A-Za-z-]", "^[a-zA-Z][-a-zA-Z0-9]{1,61}[a-zA-Z0-9]$", true, "resourceGroup", "" },
+    "azurerm_web_pubsub_hub": {"azurerm_web_pubsub_hub", "pshub", 1, 128,  false, "[^0-9A-Za-z_`,.\\[\\]]", "^[a-zA-Z][a-zA-Z0-9_`,.\\[\\]]{0,127}$", false, "parent", "" },
+    "azurerm_windows_virtual_machine": {"azurerm_windows_virtual_machine", "vm", 1, 15,  false, "[\\/\"\\[\\]:|<>+=;,?*@&_]", "^[^\\/\"\\[\\]:|<>+=;,?*@&_][^\\/\"\\[\\]:|<>+=;,?*@&]{0,13}[^\\/\"\\[\\]:|<>+=;,?*@&.-]$", true, "resourceGroup", "Microsoft.Compute/virtualMachines" },
+    "azurerm_windows_virtual_machine_scale_set": {"azurerm_windows_virtual_machine_scale_set", "vmss", 1, 15,  false, "[\\/\"\\[\\]:|<>+=;,?*@&_]", "^[^\\/\"\\[\\]:|<>+=;,?*@&_][^\\/\"\\[\\]:|<>+=;,?*@&]{0,13}[^\\/\"\\[\\]:|<>+=;,?*@&.-]$", true, "resourceGroup", "Microsoft.Compute/virtualMachineScaleSets" },
+    "azurerm_windows_web_app": {"azurerm_windows_web_app", "wwapp", 2, 60,  false, "[^0-9A-Za-z-]", "^[0-9A-Za-z][0-9A-Za-z-]{0,58}[0-9a-zA-Z]$", true, "global", "" },
+    "databricks_cluster": {"databricks_cluster", "dbc", 3, 30,  false, "[^a-zA-Z0-9-_]", "^[a-zA-Z0-9-_]{3,30}$", true, "parent", "" },
+    "databricks_high_concurrency_cluster": {"databricks_high_concurrency_cluster", "dbhcc", 3, 30,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{3,30}$", true, "parent", "" },
+    "databricks_standard_cluster": {"databricks_standard_cluster", "dbsc", 3, 30,  false, "[^a-zA-Z0-9-_]", "^[a-zA-Z0-9-_]{3,30}$", true, "parent", "" },
+    "general": {"general", "", 1, 250,  false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,250}$", true, "global", "" },
+    "general_safe": {"general_safe", "", 1, 250,  true, "[^a-z]", "^[a-z]{1,250}$", false, "global", "" },
 }
 
 // ResourceMaps are a map from the slug to the resource definition
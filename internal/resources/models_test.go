@@ -0,0 +1,82 @@
+package resources
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"terraform-provider-azname/internal/overrides"
+)
+
+func TestGetResourceDefinition(t *testing.T) {
+	tests := []struct {
+		name         string
+		resourceType string
+		expected     string
+	}{
+		{"Resource type name", "azurerm_key_vault", "azurerm_key_vault"},
+		{"CAF slug", "kv", "azurerm_key_vault"},
+		{"Provider namespace", "Microsoft.KeyVault/vaults", "azurerm_key_vault"},
+		{"Provider namespace ignores case", "microsoft.keyvault/VAULTS", "azurerm_key_vault"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource, err := GetResourceDefinition(tt.resourceType)
+			if err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+			if resource.ResourceTypeName != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, resource.ResourceTypeName)
+			}
+		})
+	}
+
+	t.Run("Ambiguous slug lists candidates", func(t *testing.T) {
+		_, err := GetResourceDefinition("vm")
+		if err == nil {
+			t.Fatal("Expected error for ambiguous slug, got nil")
+		}
+		if !strings.Contains(err.Error(), "azurerm_linux_virtual_machine") || !strings.Contains(err.Error(), "azurerm_windows_virtual_machine") {
+			t.Errorf("Expected error to list candidates, got: %v", err)
+		}
+	})
+
+	t.Run("Unknown resource type", func(t *testing.T) {
+		_, err := GetResourceDefinition("azurerm_not_a_resource")
+		if err == nil {
+			t.Fatal("Expected error for unknown resource type, got nil")
+		}
+	})
+
+	t.Run("Unknown resource type suggests close matches", func(t *testing.T) {
+		_, err := GetResourceDefinition("azurerm_key_valut")
+		if err == nil {
+			t.Fatal("Expected error for unknown resource type, got nil")
+		}
+		if !strings.Contains(err.Error(), "did you mean azurerm_key_vault") {
+			t.Errorf("Expected suggestion for azurerm_key_vault, got: %v", err)
+		}
+	})
+}
+
+func TestApplyOverridesResourceTemplates(t *testing.T) {
+	ApplyOverrides(context.Background(), &overrides.Overrides{
+		ResourceTemplateOverrides: map[string]string{
+			"st":                     "{environment}{resource_type}{workload}{rand}",
+			"azurerm_not_a_resource": "{workload}",
+		},
+	})
+
+	template, ok := GetResourceTemplate("azurerm_storage_account")
+	if !ok {
+		t.Fatal("Expected template override for azurerm_storage_account")
+	}
+	if template != "{environment}{resource_type}{workload}{rand}" {
+		t.Errorf("Expected storage account template, got %s", template)
+	}
+
+	if _, ok := GetResourceTemplate("azurerm_key_vault"); ok {
+		t.Error("Expected no template override for azurerm_key_vault")
+	}
+}
@@ -0,0 +1,104 @@
+package suggest
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// minInputLength is the length below which inputs get no suggestions.
+const minInputLength = 4
+
+// Closest returns up to limit candidate names that are the closest match for
+// input, best match first. Each candidate is keyed by the name to suggest and
+// can be matched by any of its aliases (e.g. a slug or display name).
+// Comparison ignores case and any characters other than letters and digits.
+func Closest(input string, candidates map[string][]string, limit int) []string {
+	// Short inputs such as "law" are within an edit or two of many unrelated
+	// slugs, so they get no suggestions
+	normalizedInput := normalize(input)
+	if len(normalizedInput) < minInputLength {
+		return nil
+	}
+
+	// Allow roughly one edit for every three characters
+	threshold := len(normalizedInput) / 3
+
+	type match struct {
+		name     string
+		distance int
+	}
+	var matches []match
+
+	for name, aliases := range candidates {
+		best := -1
+		for _, alias := range aliases {
+			distance := levenshtein(normalizedInput, normalize(alias))
+			if best == -1 || distance < best {
+				best = distance
+			}
+		}
+		if best != -1 && best <= threshold {
+			matches = append(matches, match{name, best})
+		}
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].distance != matches[j].distance {
+			return matches[i].distance < matches[j].distance
+		}
+		return matches[i].name < matches[j].name
+	})
+
+	var result []string
+	for _, m := range matches[:min(len(matches), limit)] {
+		result = append(result, m.name)
+	}
+	return result
+}
+
+// DidYouMean formats suggestions for appending to an error message. It returns
+// an empty string if there are no suggestions.
+func DidYouMean(suggestions []string) string {
+	if len(suggestions) == 0 {
+		return ""
+	}
+	return fmt.Sprintf(" (did you mean %s?)", strings.Join(suggestions, ", "))
+}
+
+// normalize lowercases s and drops everything but letters and digits, so
+// "West US 2", "west-us-2" and "westus2" compare equal.
+func normalize(s string) string {
+	var sb strings.Builder
+	for _, r := range strings.ToLower(s) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
+
+// levenshtein returns the edit distance between a and b.
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	previous := make([]int, len(rb)+1)
+	current := make([]int, len(rb)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		current[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+
+	return previous[len(rb)]
+}
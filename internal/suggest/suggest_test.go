@@ -0,0 +1,57 @@
+package suggest
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestClosest(t *testing.T) {
+	candidates := map[string][]string{
+		"westus":  {"westus", "West US", "wus"},
+		"westus2": {"westus2", "West US 2", "wus2"},
+		"eastus":  {"eastus", "East US", "eus"},
+		"uksouth": {"uksouth", "UK South", "uks"},
+	}
+
+	tests := []struct {
+		name     string
+		input    string
+		limit    int
+		expected []string
+	}{
+		{"Typo", "wetsus2", 3, []string{"westus2"}},
+		{"Display name with different spacing", "West-US-2", 3, []string{"westus2", "westus"}},
+		{"Limit", "westus-2", 1, []string{"westus2"}},
+		{"Nothing close", "australiaeast", 3, nil},
+		{"Short input", "law", 3, nil},
+		{"Short unrelated input", "func", 3, nil},
+		{"Empty input", "", 3, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Closest(tt.input, candidates, tt.limit)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestLevenshtein(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{"", "", 0},
+		{"abc", "", 3},
+		{"kitten", "sitting", 3},
+		{"keyvault", "keyvalut", 2},
+	}
+
+	for _, tt := range tests {
+		if got := levenshtein(tt.a, tt.b); got != tt.expected {
+			t.Errorf("levenshtein(%q, %q): expected %d, got %d", tt.a, tt.b, tt.expected, got)
+		}
+	}
+}